@@ -8,6 +8,9 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/Jitria/SentryFlow/protobuf"
 )
@@ -19,6 +22,10 @@ type Feeder struct {
 	client            pb.SentryFlowClient
 	logStream         pb.SentryFlow_GetAPILogClient
 	envoyMetricStream pb.SentryFlow_GetEnvoyMetricsClient
+	connLogStream     pb.SentryFlow_GetConnectionLogClient
+	callTreeStream    pb.SentryFlow_GetCallTreeClient
+	auditLogStream    pb.SentryFlow_GetAuditLogClient
+	noticeStream      pb.SentryFlow_GetClusterNoticeClient
 
 	Done chan struct{}
 }
@@ -72,6 +79,34 @@ func NewClient(client pb.SentryFlowClient, clientInfo *pb.ClientInfo, logCfg str
 		}
 
 		fd.logStream = logStream
+
+		connLogStream, err := client.GetConnectionLog(context.Background(), clientInfo)
+		if err != nil {
+			log.Fatalf("[Client] Could not get connection log: %v", err)
+		}
+
+		fd.connLogStream = connLogStream
+
+		callTreeStream, err := client.GetCallTree(context.Background(), clientInfo)
+		if err != nil {
+			log.Fatalf("[Client] Could not get call tree: %v", err)
+		}
+
+		fd.callTreeStream = callTreeStream
+
+		auditLogStream, err := client.GetAuditLog(context.Background(), clientInfo)
+		if err != nil {
+			log.Fatalf("[Client] Could not get audit log: %v", err)
+		}
+
+		fd.auditLogStream = auditLogStream
+
+		noticeStream, err := client.GetClusterNotice(context.Background(), clientInfo)
+		if err != nil {
+			log.Fatalf("[Client] Could not get cluster notice: %v", err)
+		}
+
+		fd.noticeStream = noticeStream
 	}
 
 	if metricCfg != "none" && (metricFilter == "all" || metricFilter == "envoy") {
@@ -112,6 +147,111 @@ func (fd *Feeder) APILogRoutine(logCfg string) {
 	}
 }
 
+// ClusterNoticeRoutine Function
+func (fd *Feeder) ClusterNoticeRoutine(logCfg string) {
+	for fd.Running {
+		select {
+		default:
+			data, err := fd.noticeStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] Failed to receive a cluster notice: %v", err)
+				break
+			}
+
+			str := ""
+			str = str + "== Cluster Notice ==\n"
+			str = str + fmt.Sprintf("%v\n", data)
+
+			if logCfg == "stdout" {
+				fmt.Printf("%s", str)
+			} else {
+				StrToFile(str, logCfg)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// AuditLogRoutine Function
+func (fd *Feeder) AuditLogRoutine(logCfg string) {
+	for fd.Running {
+		select {
+		default:
+			data, err := fd.auditLogStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] Failed to receive an audit log: %v", err)
+				break
+			}
+
+			str := ""
+			str = str + "== Audit Log ==\n"
+			str = str + fmt.Sprintf("%v\n", data)
+
+			if logCfg == "stdout" {
+				fmt.Printf("%s", str)
+			} else {
+				StrToFile(str, logCfg)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// CallTreeRoutine Function
+func (fd *Feeder) CallTreeRoutine(logCfg string) {
+	for fd.Running {
+		select {
+		default:
+			data, err := fd.callTreeStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] Failed to receive a call tree: %v", err)
+				break
+			}
+
+			str := ""
+			// The spans are printed in Zipkin v2 JSON, so they can be posted to Zipkin or Jaeger as they are
+			str = str + fmt.Sprintf("== Call Tree / %s (%d calls) ==\n", data.TraceID, len(data.Spans))
+			str = str + fmt.Sprintf("%s\n", data.ZipkinJSON)
+
+			if logCfg == "stdout" {
+				fmt.Printf("%s", str)
+			} else {
+				StrToFile(str, logCfg)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// ConnectionLogRoutine Function
+func (fd *Feeder) ConnectionLogRoutine(logCfg string) {
+	for fd.Running {
+		select {
+		default:
+			data, err := fd.connLogStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] Failed to receive a connection log: %v", err)
+				break
+			}
+
+			str := ""
+			str = str + "== Connection Log ==\n"
+			str = str + fmt.Sprintf("%v\n", data)
+
+			if logCfg == "stdout" {
+				fmt.Printf("%s", str)
+			} else {
+				StrToFile(str, logCfg)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
 // EnvoyMetricsRoutine Function
 func (fd *Feeder) EnvoyMetricsRoutine(metricCfg string) {
 	metricKeys := []string{"GAUGE", "COUNTER", "HISTOGRAM", "SUMMARY"}
@@ -145,3 +285,181 @@ func (fd *Feeder) EnvoyMetricsRoutine(metricCfg string) {
 		}
 	}
 }
+
+// PrintAgents Function that prints the agents registered with SentryFlow
+func PrintAgents(client pb.SentryFlowClient, clientInfo *pb.ClientInfo) error {
+	agentList, err := client.ListAgents(context.Background(), clientInfo)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%-32s %-16s %-12s %-8s %-8s %-12s %-12s %s\n", "AGENT", "CLUSTER", "VERSION", "STATUS", "LEADER", "HEARTBEAT", "DATA", "COLLECTORS")
+
+	for _, agent := range agentList.Agents {
+		status := "Ready"
+		if agent.Stale {
+			status = "Stale"
+		}
+
+		// agents that watch several clusters list all of them
+		cluster := agent.Info.Cluster
+		if len(agent.Info.Clusters) > 1 {
+			cluster = strings.Join(agent.Info.Clusters, ",")
+		}
+
+		fmt.Printf("%-32s %-16s %-12s %-8s %-8v %-12s %-12s %v\n",
+			agent.Info.AgentID, cluster, agent.Info.Version, status, agent.Leader,
+			agent.LastHeartbeat, agent.LastData, agent.Info.Collectors)
+	}
+
+	return nil
+}
+
+// ParseAPILogQuery Function that builds a query for the last given duration from comma-separated filters
+// (e.g., "dstNamespace=default,method=GET,pathPrefix=/api,responseCode=500-599,order=desc")
+func ParseAPILogQuery(lookBack time.Duration, filter string) (*pb.APILogQuery, error) {
+	query := &pb.APILogQuery{
+		Since:    time.Now().Add(-lookBack).Unix(),
+		PageSize: 500,
+	}
+
+	for _, kv := range strings.Split(filter, ",") {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(kv, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid filter %q (expected key=value)", kv)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		switch key {
+		case "srcCluster":
+			query.SrcCluster = value
+		case "srcNamespace":
+			query.SrcNamespace = value
+		case "srcName":
+			query.SrcName = value
+		case "srcType":
+			query.SrcType = value
+		case "dstCluster":
+			query.DstCluster = value
+		case "dstNamespace":
+			query.DstNamespace = value
+		case "dstName":
+			query.DstName = value
+		case "dstType":
+			query.DstType = value
+		case "method":
+			query.Method = value
+		case "pathPrefix":
+			query.PathPrefix = value
+		case "responseCode":
+			low, high, _ := strings.Cut(value, "-")
+			lowest, err := strconv.Atoi(low)
+			if err != nil {
+				return nil, fmt.Errorf("invalid response code %q", value)
+			}
+			highest := lowest
+			if high != "" {
+				if highest, err = strconv.Atoi(high); err != nil {
+					return nil, fmt.Errorf("invalid response code %q", value)
+				}
+			}
+			query.ResponseCodes = append(query.ResponseCodes, &pb.ResponseCodeRange{Min: int32(lowest), Max: int32(highest)})
+		case "order":
+			query.Descending = value == "desc"
+		default:
+			return nil, fmt.Errorf("unknown filter %q", key)
+		}
+	}
+
+	return query, nil
+}
+
+// QueryAPILogs Function that prints the stored API logs that match a query, page by page
+func QueryAPILogs(client pb.SentryFlowClient, query *pb.APILogQuery, logCfg string) error {
+	total := 0
+
+	for {
+		page, err := client.QueryAPILogs(context.Background(), query)
+		if err != nil {
+			return err
+		}
+
+		for _, apiLog := range page.ApiLogs {
+			str := ""
+			str = str + "== API Log ==\n"
+			str = str + fmt.Sprintf("%v\n", apiLog)
+
+			if logCfg == "stdout" || logCfg == "none" {
+				fmt.Printf("%s", str)
+			} else {
+				StrToFile(str, logCfg)
+			}
+		}
+		total += len(page.ApiLogs)
+
+		if page.NextPageToken == "" {
+			break
+		}
+		query.PageToken = page.NextPageToken
+	}
+
+	log.Printf("[Client] Found %d API logs", total)
+
+	return nil
+}
+
+// PrintServiceGraph Function that prints the service graph as a table of edges or in a given format {table|dot|mermaid|cytoscape}
+func PrintServiceGraph(client pb.SentryFlowClient, query *pb.ServiceGraphQuery, format string, opts GraphRenderOptions) error {
+	graph, err := client.GetServiceGraph(context.Background(), query)
+	if err != nil {
+		return err
+	}
+
+	if format != "table" {
+		rendered, err := RenderServiceGraph(graph, format, opts)
+		if err != nil {
+			return err
+		}
+		fmt.Print(rendered)
+		return nil
+	}
+
+	fmt.Printf("%-48s %-48s %-10s %-8s %-10s %-10s %s\n", "SOURCE", "DESTINATION", "REQUESTS", "ERRORS", "P50(ms)", "P99(ms)", "LAST SEEN")
+
+	for _, edge := range graph.Edges {
+		fmt.Printf("%-48s %-48s %-10d %-8.2f %-10.1f %-10.1f %s\n",
+			edge.Source, edge.Destination, edge.Requests, edge.ErrorRate*100, edge.LatencyP50, edge.LatencyP99,
+			time.Unix(edge.LastSeen, 0).UTC().Format(time.RFC3339))
+	}
+
+	log.Printf("[Client] Found %d nodes and %d edges", len(graph.Nodes), len(graph.Edges))
+
+	return nil
+}
+
+// WatchServiceGraph Function that prints the changes of the service graph until the stream ends
+func WatchServiceGraph(client pb.SentryFlowClient, query *pb.ServiceGraphQuery) error {
+	stream, err := client.WatchServiceGraph(context.Background(), query)
+	if err != nil {
+		return err
+	}
+
+	for {
+		change, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		if change.Node != nil {
+			fmt.Printf("%s %s %s\n", change.TimeStamp, change.Type, change.Node.Id)
+		} else if change.Edge != nil {
+			fmt.Printf("%s %s %s -> %s (requests=%d, errorRate=%.2f%%, p99=%.1fms)\n",
+				change.TimeStamp, change.Type, change.Edge.Source, change.Edge.Destination,
+				change.Edge.Requests, change.Edge.ErrorRate*100, change.Edge.LatencyP99)
+		}
+	}
+}
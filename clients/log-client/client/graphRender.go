@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// GraphRenderOptions Structure
+type GraphRenderOptions struct {
+	GroupBy     string        // "cluster", "namespace" or "" for no grouping
+	Window      time.Duration // duration that the requests of edges were counted over (0: from first to last seen)
+	FailingRate float64       // error rate from which an edge is highlighted as failing
+}
+
+// Colors of edges
+const (
+	healthyEdgeColor = "#7f7f7f"
+	failingEdgeColor = "#d62728"
+)
+
+// RenderServiceGraph Function that renders a service graph in a given format {dot|mermaid|cytoscape}
+func RenderServiceGraph(graph *pb.ServiceGraph, format string, opts GraphRenderOptions) (string, error) {
+	if opts.GroupBy != "" && opts.GroupBy != "cluster" && opts.GroupBy != "namespace" {
+		return "", fmt.Errorf("unknown grouping %q", opts.GroupBy)
+	}
+
+	switch format {
+	case "dot":
+		return RenderDOT(graph, opts), nil
+	case "mermaid":
+		return RenderMermaid(graph, opts), nil
+	case "cytoscape":
+		return RenderCytoscape(graph, opts)
+	default:
+		return "", fmt.Errorf("unknown graph format %q", format)
+	}
+}
+
+// == //
+
+// RenderDOT Function that renders a service graph as Graphviz DOT
+func RenderDOT(graph *pb.ServiceGraph, opts GraphRenderOptions) string {
+	var sb strings.Builder
+
+	sb.WriteString("digraph sentryflow {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [fontname=\"Helvetica\"];\n")
+	sb.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	writeNode := func(indent string, node *pb.GraphNode) {
+		fmt.Fprintf(&sb, "%s%s [label=%s, shape=%s];\n", indent, dotQuote(node.Id), dotQuote(node.Name+"\n"+node.Type), dotShape(node.Type))
+	}
+
+	groups, members := groupNodes(graph.Nodes, opts.GroupBy)
+	for i, group := range groups {
+		if group == "" {
+			for _, node := range members[group] {
+				writeNode("  ", node)
+			}
+			continue
+		}
+
+		// Graphviz draws subgraphs named "cluster*" as boxes
+		fmt.Fprintf(&sb, "  subgraph cluster_%d {\n", i)
+		fmt.Fprintf(&sb, "    label=%s;\n", dotQuote(group))
+		for _, node := range members[group] {
+			writeNode("    ", node)
+		}
+		sb.WriteString("  }\n")
+	}
+
+	for _, edge := range graph.Edges {
+		color, width := healthyEdgeColor, 1
+		if failingEdge(edge, opts) {
+			color, width = failingEdgeColor, 3
+		}
+
+		fmt.Fprintf(&sb, "  %s -> %s [label=%s, color=%s, fontcolor=%s, penwidth=%d];\n",
+			dotQuote(edge.Source), dotQuote(edge.Destination), dotQuote(edgeLabel(edge, opts, "\n")),
+			dotQuote(color), dotQuote(color), width)
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// dotQuote Function that quotes an ID or a label of DOT
+func dotQuote(str string) string {
+	str = strings.ReplaceAll(str, `\`, `\\`)
+	str = strings.ReplaceAll(str, `"`, `\"`)
+	str = strings.ReplaceAll(str, "\n", `\n`)
+	return `"` + str + `"`
+}
+
+// dotShape Function that returns the shape of a node type
+func dotShape(nodeType string) string {
+	switch nodeType {
+	case "Service":
+		return "ellipse"
+	case "External":
+		return "hexagon"
+	default:
+		return "box"
+	}
+}
+
+// == //
+
+// RenderMermaid Function that renders a service graph as a Mermaid flowchart
+func RenderMermaid(graph *pb.ServiceGraph, opts GraphRenderOptions) string {
+	var sb strings.Builder
+
+	sb.WriteString("flowchart LR\n")
+
+	// Mermaid IDs cannot hold the slashes of node IDs
+	ids := make(map[string]string, len(graph.Nodes))
+	for i, node := range graph.Nodes {
+		ids[node.Id] = fmt.Sprintf("n%d", i)
+	}
+
+	writeNode := func(indent string, node *pb.GraphNode) {
+		label := mermaidQuote(node.Name + "<br/>" + node.Type)
+		switch node.Type {
+		case "Service":
+			fmt.Fprintf(&sb, "%s%s([%s])\n", indent, ids[node.Id], label)
+		case "External":
+			fmt.Fprintf(&sb, "%s%s{{%s}}\n", indent, ids[node.Id], label)
+		default:
+			fmt.Fprintf(&sb, "%s%s[%s]\n", indent, ids[node.Id], label)
+		}
+	}
+
+	groups, members := groupNodes(graph.Nodes, opts.GroupBy)
+	for i, group := range groups {
+		if group == "" {
+			for _, node := range members[group] {
+				writeNode("  ", node)
+			}
+			continue
+		}
+
+		fmt.Fprintf(&sb, "  subgraph g%d[%s]\n", i, mermaidQuote(group))
+		for _, node := range members[group] {
+			writeNode("    ", node)
+		}
+		sb.WriteString("  end\n")
+	}
+
+	failing := make([]string, 0)
+	for i, edge := range graph.Edges {
+		src, ok := ids[edge.Source]
+		if !ok {
+			src = mermaidUnknownNode(&sb, ids, edge.Source)
+		}
+		dst, ok := ids[edge.Destination]
+		if !ok {
+			dst = mermaidUnknownNode(&sb, ids, edge.Destination)
+		}
+
+		fmt.Fprintf(&sb, "  %s -->|%s| %s\n", src, mermaidQuote(edgeLabel(edge, opts, "<br/>")), dst)
+
+		if failingEdge(edge, opts) {
+			failing = append(failing, fmt.Sprintf("%d", i))
+		}
+	}
+
+	fmt.Fprintf(&sb, "  linkStyle default stroke:%s\n", healthyEdgeColor)
+	if len(failing) > 0 {
+		fmt.Fprintf(&sb, "  linkStyle %s stroke:%s,stroke-width:3px,color:%s\n", strings.Join(failing, ","), failingEdgeColor, failingEdgeColor)
+	}
+
+	return sb.String()
+}
+
+// mermaidUnknownNode Function that declares a node that an edge refers to but the graph does not hold
+func mermaidUnknownNode(sb *strings.Builder, ids map[string]string, nodeID string) string {
+	id := fmt.Sprintf("n%d", len(ids))
+	ids[nodeID] = id
+	fmt.Fprintf(sb, "  %s[%s]\n", id, mermaidQuote(nodeID))
+	return id
+}
+
+// mermaidQuote Function that quotes a label of Mermaid
+func mermaidQuote(str string) string {
+	return `"` + strings.ReplaceAll(str, `"`, "#quot;") + `"`
+}
+
+// == //
+
+// cytoscapeElement Structure of Cytoscape.js
+type cytoscapeElement struct {
+	Data    map[string]interface{} `json:"data"`
+	Classes string                 `json:"classes,omitempty"`
+}
+
+// cytoscapeGraph Structure of Cytoscape.js
+type cytoscapeGraph struct {
+	Elements struct {
+		Nodes []cytoscapeElement `json:"nodes"`
+		Edges []cytoscapeElement `json:"edges"`
+	} `json:"elements"`
+}
+
+// RenderCytoscape Function that renders a service graph as Cytoscape.js JSON
+// (groups become compound nodes, and failing edges have the "failing" class)
+func RenderCytoscape(graph *pb.ServiceGraph, opts GraphRenderOptions) (string, error) {
+	cy := cytoscapeGraph{}
+	cy.Elements.Nodes = make([]cytoscapeElement, 0, len(graph.Nodes))
+	cy.Elements.Edges = make([]cytoscapeElement, 0, len(graph.Edges))
+
+	groups, members := groupNodes(graph.Nodes, opts.GroupBy)
+	for _, group := range groups {
+		parent := ""
+		if group != "" {
+			parent = "group:" + group
+			cy.Elements.Nodes = append(cy.Elements.Nodes, cytoscapeElement{
+				Data:    map[string]interface{}{"id": parent, "label": group},
+				Classes: "group",
+			})
+		}
+
+		for _, node := range members[group] {
+			data := map[string]interface{}{
+				"id":        node.Id,
+				"label":     node.Name,
+				"type":      node.Type,
+				"cluster":   node.Cluster,
+				"namespace": node.Namespace,
+				"firstSeen": node.FirstSeen,
+				"lastSeen":  node.LastSeen,
+			}
+			if parent != "" {
+				data["parent"] = parent
+			}
+
+			cy.Elements.Nodes = append(cy.Elements.Nodes, cytoscapeElement{Data: data, Classes: strings.ToLower(node.Type)})
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		element := cytoscapeElement{
+			Data: map[string]interface{}{
+				"id":         edge.Id,
+				"source":     edge.Source,
+				"target":     edge.Destination,
+				"label":      edgeLabel(edge, opts, "\n"),
+				"requests":   edge.Requests,
+				"rps":        edgeRPS(edge, opts),
+				"errors":     edge.Errors,
+				"errorRate":  edge.ErrorRate,
+				"latencyP50": edge.LatencyP50,
+				"latencyP90": edge.LatencyP90,
+				"latencyP99": edge.LatencyP99,
+				"methods":    edge.Methods,
+				"endpoints":  edge.Endpoints,
+				"firstSeen":  edge.FirstSeen,
+				"lastSeen":   edge.LastSeen,
+			},
+		}
+		if failingEdge(edge, opts) {
+			element.Classes = "failing"
+		}
+
+		cy.Elements.Edges = append(cy.Elements.Edges, element)
+	}
+
+	data, err := json.MarshalIndent(cy, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data) + "\n", nil
+}
+
+// == //
+
+// groupNodes Function that splits nodes into the groups of a grouping, in the order the groups first appear
+// (without grouping, all nodes are in the group "")
+func groupNodes(nodes []*pb.GraphNode, groupBy string) ([]string, map[string][]*pb.GraphNode) {
+	groups := make([]string, 0)
+	members := make(map[string][]*pb.GraphNode)
+
+	for _, node := range nodes {
+		group := ""
+		switch groupBy {
+		case "cluster":
+			group = node.Cluster
+		case "namespace":
+			group = node.Cluster + "/" + node.Namespace
+		}
+
+		if _, ok := members[group]; !ok {
+			groups = append(groups, group)
+		}
+		members[group] = append(members[group], node)
+	}
+
+	return groups, members
+}
+
+// edgeRPS Function that returns the requests per second of an edge
+func edgeRPS(edge *pb.GraphEdge, opts GraphRenderOptions) float64 {
+	seconds := opts.Window.Seconds()
+	if seconds <= 0 {
+		seconds = float64(edge.LastSeen - edge.FirstSeen)
+	}
+	if seconds < 1 {
+		seconds = 1
+	}
+	return float64(edge.Requests) / seconds
+}
+
+// edgeLabel Function that returns the label of an edge (its RPS and error rate)
+func edgeLabel(edge *pb.GraphEdge, opts GraphRenderOptions, sep string) string {
+	return fmt.Sprintf("%.2f rps%s%.1f%% errors", edgeRPS(edge, opts), sep, edge.ErrorRate*100)
+}
+
+// failingEdge Function that checks if an edge fails often enough to be highlighted
+func failingEdge(edge *pb.GraphEdge, opts GraphRenderOptions) bool {
+	return edge.Errors > 0 && edge.ErrorRate >= opts.FailingRate
+}
+
+// == //
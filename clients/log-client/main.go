@@ -32,9 +32,20 @@ func main() {
 	logCfgPtr := flag.String("logCfg", "stdout", "Output location for API logs, {stdout|file|none}")
 	metricCfgPtr := flag.String("metricCfg", "stdout", "Output location for API and Envoy metrics, {stdout|file|none}")
 	metricFilterPtr := flag.String("metricFilter", "envoy", "Filter to select specific API or Envoy metrics to receive, {api|envoy}")
+	listAgentsPtr := flag.Bool("listAgents", false, "Print the agents registered with SentryFlow and exit")
+	queryAPILogsPtr := flag.Duration("queryAPILogs", 0, "Print the API logs stored in the last given duration (e.g., 1h) and exit")
+	queryFilterPtr := flag.String("queryFilter", "", "Filters for -queryAPILogs, e.g., dstNamespace=default,method=GET,pathPrefix=/api,responseCode=500-599,order=desc")
+	serviceGraphPtr := flag.Duration("serviceGraph", 0, "Print the service graph of the calls in the last given duration (e.g., 15m) and exit")
+	watchServiceGraphPtr := flag.Bool("watchServiceGraph", false, "Print the changes of the service graph as they happen")
+	graphClusterPtr := flag.String("graphCluster", "", "Cluster that -serviceGraph and -watchServiceGraph are limited to")
+	graphNamespacePtr := flag.String("graphNamespace", "", "Namespace that -serviceGraph and -watchServiceGraph are limited to")
+	graphFormatPtr := flag.String("graphFormat", "table", "Output format of -serviceGraph, {table|dot|mermaid|cytoscape}")
+	graphGroupByPtr := flag.String("graphGroupBy", "", "Grouping of nodes in -graphFormat diagrams, {cluster|namespace} (empty: no grouping)")
+	graphFailingRatePtr := flag.Float64("graphFailingRate", 0.05, "Error rate from which edges are highlighted as failing in -graphFormat diagrams")
 	flag.Parse()
 
-	if *logCfgPtr == "none" && *metricCfgPtr == "none" {
+	if *logCfgPtr == "none" && *metricCfgPtr == "none" && !*listAgentsPtr && *queryAPILogsPtr == 0 &&
+		*serviceGraphPtr == 0 && !*watchServiceGraphPtr {
 		flag.PrintDefaults()
 		return
 	}
@@ -78,12 +89,63 @@ func main() {
 	// Create a gRPC client for the SentryFlow service
 	sfClient := protobuf.NewSentryFlowClient(conn)
 
+	if *listAgentsPtr {
+		if err := client.PrintAgents(sfClient, clientInfo); err != nil {
+			log.Fatalf("[Client] Could not list agents: %v", err)
+		}
+		return
+	}
+
+	if *queryAPILogsPtr > 0 {
+		query, err := client.ParseAPILogQuery(*queryAPILogsPtr, *queryFilterPtr)
+		if err != nil {
+			log.Fatalf("[Client] Invalid query: %v", err)
+		}
+		if err := client.QueryAPILogs(sfClient, query, *logCfgPtr); err != nil {
+			log.Fatalf("[Client] Could not query API logs: %v", err)
+		}
+		return
+	}
+
+	if *serviceGraphPtr > 0 || *watchServiceGraphPtr {
+		query := &protobuf.ServiceGraphQuery{
+			Window:    int64(serviceGraphPtr.Seconds()),
+			Cluster:   *graphClusterPtr,
+			Namespace: *graphNamespacePtr,
+		}
+
+		if *watchServiceGraphPtr {
+			if err := client.WatchServiceGraph(sfClient, query); err != nil {
+				log.Fatalf("[Client] Stopped watching the service graph: %v", err)
+			}
+		} else if err := client.PrintServiceGraph(sfClient, query, *graphFormatPtr, client.GraphRenderOptions{
+			GroupBy:     *graphGroupByPtr,
+			Window:      *serviceGraphPtr,
+			FailingRate: *graphFailingRatePtr,
+		}); err != nil {
+			log.Fatalf("[Client] Could not get the service graph: %v", err)
+		}
+		return
+	}
+
 	// Create a log client with the gRPC client
 	logClient := client.NewClient(sfClient, clientInfo, *logCfgPtr, *metricCfgPtr, *metricFilterPtr)
 
 	if *logCfgPtr != "none" {
 		go logClient.APILogRoutine(*logCfgPtr)
 		fmt.Printf("[APILog] Started to watch API logs\n")
+
+		go logClient.ConnectionLogRoutine(*logCfgPtr)
+		fmt.Printf("[ConnectionLog] Started to watch connection logs\n")
+
+		go logClient.CallTreeRoutine(*logCfgPtr)
+		fmt.Printf("[CallTree] Started to watch call trees\n")
+
+		go logClient.AuditLogRoutine(*logCfgPtr)
+		fmt.Printf("[AuditLog] Started to watch audit logs\n")
+
+		go logClient.ClusterNoticeRoutine(*logCfgPtr)
+		fmt.Printf("[ClusterNotice] Started to watch cluster notices\n")
 	}
 
 	if *metricCfgPtr != "none" {
@@ -17,6 +17,10 @@ type Feeder struct {
 	client            pb.SentryFlowClient
 	logStream         pb.SentryFlow_GetAPILogClient
 	envoyMetricStream pb.SentryFlow_GetEnvoyMetricsClient
+	connLogStream     pb.SentryFlow_GetConnectionLogClient
+	callTreeStream    pb.SentryFlow_GetCallTreeClient
+	auditLogStream    pb.SentryFlow_GetAuditLogClient
+	noticeStream      pb.SentryFlow_GetClusterNoticeClient
 
 	deployAddStream    pb.SentryFlow_AddDeployEventDBClient
 	deployUpdateStream pb.SentryFlow_UpdateDeployEventDBClient
@@ -30,6 +34,38 @@ type Feeder struct {
 	svcUpdateStream pb.SentryFlow_UpdateSvcEventDBClient
 	svcDeleteStream pb.SentryFlow_DeleteSvcEventDBClient
 
+	statefulSetAddStream    pb.SentryFlow_AddStatefulSetEventDBClient
+	statefulSetUpdateStream pb.SentryFlow_UpdateStatefulSetEventDBClient
+	statefulSetDeleteStream pb.SentryFlow_DeleteStatefulSetEventDBClient
+
+	daemonSetAddStream    pb.SentryFlow_AddDaemonSetEventDBClient
+	daemonSetUpdateStream pb.SentryFlow_UpdateDaemonSetEventDBClient
+	daemonSetDeleteStream pb.SentryFlow_DeleteDaemonSetEventDBClient
+
+	jobAddStream    pb.SentryFlow_AddJobEventDBClient
+	jobUpdateStream pb.SentryFlow_UpdateJobEventDBClient
+	jobDeleteStream pb.SentryFlow_DeleteJobEventDBClient
+
+	cronJobAddStream    pb.SentryFlow_AddCronJobEventDBClient
+	cronJobUpdateStream pb.SentryFlow_UpdateCronJobEventDBClient
+	cronJobDeleteStream pb.SentryFlow_DeleteCronJobEventDBClient
+
+	nodeAddStream    pb.SentryFlow_AddNodeEventDBClient
+	nodeUpdateStream pb.SentryFlow_UpdateNodeEventDBClient
+	nodeDeleteStream pb.SentryFlow_DeleteNodeEventDBClient
+
+	namespaceAddStream    pb.SentryFlow_AddNamespaceEventDBClient
+	namespaceUpdateStream pb.SentryFlow_UpdateNamespaceEventDBClient
+	namespaceDeleteStream pb.SentryFlow_DeleteNamespaceEventDBClient
+
+	ingressAddStream    pb.SentryFlow_AddIngressEventDBClient
+	ingressUpdateStream pb.SentryFlow_UpdateIngressEventDBClient
+	ingressDeleteStream pb.SentryFlow_DeleteIngressEventDBClient
+
+	serviceEndpointsAddStream    pb.SentryFlow_AddServiceEndpointsEventDBClient
+	serviceEndpointsUpdateStream pb.SentryFlow_UpdateServiceEndpointsEventDBClient
+	serviceEndpointsDeleteStream pb.SentryFlow_DeleteServiceEndpointsEventDBClient
+
 	dbHandler mongodb.DBHandler
 
 	Done chan struct{}
@@ -50,6 +86,34 @@ func NewClient(client pb.SentryFlowClient, clientInfo *pb.ClientInfo, logCfg str
 		} else {
 			fd.logStream = logStream
 		}
+
+		connLogStream, err := client.GetConnectionLog(context.Background(), clientInfo)
+		if err != nil {
+			log.Fatalf("[Client] Could not get connection log stream: %v", err)
+		} else {
+			fd.connLogStream = connLogStream
+		}
+
+		callTreeStream, err := client.GetCallTree(context.Background(), clientInfo)
+		if err != nil {
+			log.Fatalf("[Client] Could not get call tree stream: %v", err)
+		} else {
+			fd.callTreeStream = callTreeStream
+		}
+
+		auditLogStream, err := client.GetAuditLog(context.Background(), clientInfo)
+		if err != nil {
+			log.Fatalf("[Client] Could not get audit log stream: %v", err)
+		} else {
+			fd.auditLogStream = auditLogStream
+		}
+
+		noticeStream, err := client.GetClusterNotice(context.Background(), clientInfo)
+		if err != nil {
+			log.Fatalf("[Client] Could not get cluster notice stream: %v", err)
+		} else {
+			fd.noticeStream = noticeStream
+		}
 	}
 
 	// === EnvoyMetrics ===
@@ -119,6 +183,158 @@ func NewClient(client pb.SentryFlowClient, clientInfo *pb.ClientInfo, logCfg str
 		fd.svcDeleteStream = delSvcStr
 	}
 
+	// ========== StatefulSet Add/Update/Delete ==========
+	if addStatefulSetStr, err := client.AddStatefulSetEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get AddStatefulSetEventDB stream: %v", err)
+	} else {
+		fd.statefulSetAddStream = addStatefulSetStr
+	}
+
+	if updStatefulSetStr, err := client.UpdateStatefulSetEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get UpdateStatefulSetEventDB stream: %v", err)
+	} else {
+		fd.statefulSetUpdateStream = updStatefulSetStr
+	}
+
+	if delStatefulSetStr, err := client.DeleteStatefulSetEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get DeleteStatefulSetEventDB stream: %v", err)
+	} else {
+		fd.statefulSetDeleteStream = delStatefulSetStr
+	}
+
+	// ========== DaemonSet Add/Update/Delete ==========
+	if addDaemonSetStr, err := client.AddDaemonSetEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get AddDaemonSetEventDB stream: %v", err)
+	} else {
+		fd.daemonSetAddStream = addDaemonSetStr
+	}
+
+	if updDaemonSetStr, err := client.UpdateDaemonSetEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get UpdateDaemonSetEventDB stream: %v", err)
+	} else {
+		fd.daemonSetUpdateStream = updDaemonSetStr
+	}
+
+	if delDaemonSetStr, err := client.DeleteDaemonSetEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get DeleteDaemonSetEventDB stream: %v", err)
+	} else {
+		fd.daemonSetDeleteStream = delDaemonSetStr
+	}
+
+	// ========== Job Add/Update/Delete ==========
+	if addJobStr, err := client.AddJobEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get AddJobEventDB stream: %v", err)
+	} else {
+		fd.jobAddStream = addJobStr
+	}
+
+	if updJobStr, err := client.UpdateJobEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get UpdateJobEventDB stream: %v", err)
+	} else {
+		fd.jobUpdateStream = updJobStr
+	}
+
+	if delJobStr, err := client.DeleteJobEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get DeleteJobEventDB stream: %v", err)
+	} else {
+		fd.jobDeleteStream = delJobStr
+	}
+
+	// ========== CronJob Add/Update/Delete ==========
+	if addCronJobStr, err := client.AddCronJobEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get AddCronJobEventDB stream: %v", err)
+	} else {
+		fd.cronJobAddStream = addCronJobStr
+	}
+
+	if updCronJobStr, err := client.UpdateCronJobEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get UpdateCronJobEventDB stream: %v", err)
+	} else {
+		fd.cronJobUpdateStream = updCronJobStr
+	}
+
+	if delCronJobStr, err := client.DeleteCronJobEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get DeleteCronJobEventDB stream: %v", err)
+	} else {
+		fd.cronJobDeleteStream = delCronJobStr
+	}
+
+	// ========== Node Add/Update/Delete ==========
+	if addNodeStr, err := client.AddNodeEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get AddNodeEventDB stream: %v", err)
+	} else {
+		fd.nodeAddStream = addNodeStr
+	}
+
+	if updNodeStr, err := client.UpdateNodeEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get UpdateNodeEventDB stream: %v", err)
+	} else {
+		fd.nodeUpdateStream = updNodeStr
+	}
+
+	if delNodeStr, err := client.DeleteNodeEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get DeleteNodeEventDB stream: %v", err)
+	} else {
+		fd.nodeDeleteStream = delNodeStr
+	}
+
+	// ========== Namespace Add/Update/Delete ==========
+	if addNamespaceStr, err := client.AddNamespaceEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get AddNamespaceEventDB stream: %v", err)
+	} else {
+		fd.namespaceAddStream = addNamespaceStr
+	}
+
+	if updNamespaceStr, err := client.UpdateNamespaceEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get UpdateNamespaceEventDB stream: %v", err)
+	} else {
+		fd.namespaceUpdateStream = updNamespaceStr
+	}
+
+	if delNamespaceStr, err := client.DeleteNamespaceEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get DeleteNamespaceEventDB stream: %v", err)
+	} else {
+		fd.namespaceDeleteStream = delNamespaceStr
+	}
+
+	// ========== Ingress Add/Update/Delete ==========
+	if addIngressStr, err := client.AddIngressEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get AddIngressEventDB stream: %v", err)
+	} else {
+		fd.ingressAddStream = addIngressStr
+	}
+
+	if updIngressStr, err := client.UpdateIngressEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get UpdateIngressEventDB stream: %v", err)
+	} else {
+		fd.ingressUpdateStream = updIngressStr
+	}
+
+	if delIngressStr, err := client.DeleteIngressEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get DeleteIngressEventDB stream: %v", err)
+	} else {
+		fd.ingressDeleteStream = delIngressStr
+	}
+
+	// ========== ServiceEndpoints Add/Update/Delete ==========
+	if addServiceEndpointsStr, err := client.AddServiceEndpointsEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get AddServiceEndpointsEventDB stream: %v", err)
+	} else {
+		fd.serviceEndpointsAddStream = addServiceEndpointsStr
+	}
+
+	if updServiceEndpointsStr, err := client.UpdateServiceEndpointsEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get UpdateServiceEndpointsEventDB stream: %v", err)
+	} else {
+		fd.serviceEndpointsUpdateStream = updServiceEndpointsStr
+	}
+
+	if delServiceEndpointsStr, err := client.DeleteServiceEndpointsEventDB(context.Background(), clientInfo); err != nil {
+		log.Fatalf("[Client] Could not get DeleteServiceEndpointsEventDB stream: %v", err)
+	} else {
+		fd.serviceEndpointsDeleteStream = delServiceEndpointsStr
+	}
+
 	// ========== MongoDB 연결 ==========
 	dbHandler, err := mongodb.NewMongoDBHandler(mongoDBAddr)
 	if err != nil {
@@ -159,6 +375,114 @@ func (fd *Feeder) APILogRoutine(logCfg string) {
 	}
 }
 
+// ClusterNoticeRoutine Function
+func (fd *Feeder) ClusterNoticeRoutine() {
+	if fd.noticeStream == nil {
+		log.Printf("[ClusterNoticeRoutine] noticeStream is nil, cannot receive notices.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			data, err := fd.noticeStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] Failed to receive a cluster notice: %v", err)
+				return
+			}
+			err = fd.dbHandler.InsertClusterNotice(data)
+			if err != nil {
+				log.Printf("[MongoDB] Failed to insert a cluster notice: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted cluster notice at timestamp: %s", data.TimeStamp)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// AuditLogRoutine Function
+func (fd *Feeder) AuditLogRoutine() {
+	if fd.auditLogStream == nil {
+		log.Printf("[AuditLogRoutine] auditLogStream is nil, cannot receive logs.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			data, err := fd.auditLogStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] Failed to receive an audit log: %v", err)
+				return
+			}
+			err = fd.dbHandler.InsertAuditLog(data)
+			if err != nil {
+				log.Printf("[MongoDB] Failed to insert an audit log: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted audit log at timestamp: %s", data.TimeStamp)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// CallTreeRoutine Function
+func (fd *Feeder) CallTreeRoutine() {
+	if fd.callTreeStream == nil {
+		log.Printf("[CallTreeRoutine] callTreeStream is nil, cannot receive logs.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			data, err := fd.callTreeStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] Failed to receive a call tree: %v", err)
+				return
+			}
+			err = fd.dbHandler.InsertCallTree(data)
+			if err != nil {
+				log.Printf("[MongoDB] Failed to insert a call tree: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted call tree of trace: %s", data.TraceID)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// ConnectionLogRoutine Function
+func (fd *Feeder) ConnectionLogRoutine() {
+	if fd.connLogStream == nil {
+		log.Printf("[ConnectionLogRoutine] connLogStream is nil, cannot receive logs.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			data, err := fd.connLogStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] Failed to receive a connection log: %v", err)
+				return
+			}
+			err = fd.dbHandler.InsertConnectionLog(data)
+			if err != nil {
+				log.Printf("[MongoDB] Failed to insert a connection log: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted connection log at timestamp: %s", data.TimeStamp)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
 // EnvoyMetricsRoutine Function
 func (fd *Feeder) EnvoyMetricsRoutine(metricCfg string) {
 	if fd.envoyMetricStream == nil {
@@ -419,3 +743,627 @@ func (fd *Feeder) ServiceDeleteRoutine() {
 		}
 	}
 }
+
+// StatefulSetAddRoutine Function
+func (fd *Feeder) StatefulSetAddRoutine() {
+	if fd.statefulSetAddStream == nil {
+		log.Printf("[StatefulSetAddRoutine] statefulSetAddStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			sts, err := fd.statefulSetAddStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] StatefulSetAdd stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.InsertStatefulSet(sts); err != nil {
+				log.Printf("[MongoDB] InsertStatefulSet(Add) error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted statefulset event for: %s", sts.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// StatefulSetUpdateRoutine Function
+func (fd *Feeder) StatefulSetUpdateRoutine() {
+	if fd.statefulSetUpdateStream == nil {
+		log.Printf("[StatefulSetUpdateRoutine] statefulSetUpdateStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			sts, err := fd.statefulSetUpdateStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] StatefulSetUpdate stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.UpdateStatefulSet(sts); err != nil {
+				log.Printf("[MongoDB] UpdateStatefulSet error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully updated statefulset event for: %s", sts.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// StatefulSetDeleteRoutine Function
+func (fd *Feeder) StatefulSetDeleteRoutine() {
+	if fd.statefulSetDeleteStream == nil {
+		log.Printf("[StatefulSetDeleteRoutine] statefulSetDeleteStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			sts, err := fd.statefulSetDeleteStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] StatefulSetDelete stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.DeleteStatefulSet(sts); err != nil {
+				log.Printf("[MongoDB] DeleteStatefulSet error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully deleted statefulset event for: %s", sts.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// DaemonSetAddRoutine Function
+func (fd *Feeder) DaemonSetAddRoutine() {
+	if fd.daemonSetAddStream == nil {
+		log.Printf("[DaemonSetAddRoutine] daemonSetAddStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ds, err := fd.daemonSetAddStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] DaemonSetAdd stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.InsertDaemonSet(ds); err != nil {
+				log.Printf("[MongoDB] InsertDaemonSet(Add) error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted daemonset event for: %s", ds.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// DaemonSetUpdateRoutine Function
+func (fd *Feeder) DaemonSetUpdateRoutine() {
+	if fd.daemonSetUpdateStream == nil {
+		log.Printf("[DaemonSetUpdateRoutine] daemonSetUpdateStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ds, err := fd.daemonSetUpdateStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] DaemonSetUpdate stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.UpdateDaemonSet(ds); err != nil {
+				log.Printf("[MongoDB] UpdateDaemonSet error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully updated daemonset event for: %s", ds.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// DaemonSetDeleteRoutine Function
+func (fd *Feeder) DaemonSetDeleteRoutine() {
+	if fd.daemonSetDeleteStream == nil {
+		log.Printf("[DaemonSetDeleteRoutine] daemonSetDeleteStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ds, err := fd.daemonSetDeleteStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] DaemonSetDelete stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.DeleteDaemonSet(ds); err != nil {
+				log.Printf("[MongoDB] DeleteDaemonSet error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully deleted daemonset event for: %s", ds.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// JobAddRoutine Function
+func (fd *Feeder) JobAddRoutine() {
+	if fd.jobAddStream == nil {
+		log.Printf("[JobAddRoutine] jobAddStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			job, err := fd.jobAddStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] JobAdd stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.InsertJob(job); err != nil {
+				log.Printf("[MongoDB] InsertJob(Add) error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted job event for: %s", job.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// JobUpdateRoutine Function
+func (fd *Feeder) JobUpdateRoutine() {
+	if fd.jobUpdateStream == nil {
+		log.Printf("[JobUpdateRoutine] jobUpdateStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			job, err := fd.jobUpdateStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] JobUpdate stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.UpdateJob(job); err != nil {
+				log.Printf("[MongoDB] UpdateJob error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully updated job event for: %s", job.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// JobDeleteRoutine Function
+func (fd *Feeder) JobDeleteRoutine() {
+	if fd.jobDeleteStream == nil {
+		log.Printf("[JobDeleteRoutine] jobDeleteStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			job, err := fd.jobDeleteStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] JobDelete stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.DeleteJob(job); err != nil {
+				log.Printf("[MongoDB] DeleteJob error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully deleted job event for: %s", job.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// CronJobAddRoutine Function
+func (fd *Feeder) CronJobAddRoutine() {
+	if fd.cronJobAddStream == nil {
+		log.Printf("[CronJobAddRoutine] cronJobAddStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			cj, err := fd.cronJobAddStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] CronJobAdd stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.InsertCronJob(cj); err != nil {
+				log.Printf("[MongoDB] InsertCronJob(Add) error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted cronjob event for: %s", cj.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// CronJobUpdateRoutine Function
+func (fd *Feeder) CronJobUpdateRoutine() {
+	if fd.cronJobUpdateStream == nil {
+		log.Printf("[CronJobUpdateRoutine] cronJobUpdateStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			cj, err := fd.cronJobUpdateStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] CronJobUpdate stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.UpdateCronJob(cj); err != nil {
+				log.Printf("[MongoDB] UpdateCronJob error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully updated cronjob event for: %s", cj.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// CronJobDeleteRoutine Function
+func (fd *Feeder) CronJobDeleteRoutine() {
+	if fd.cronJobDeleteStream == nil {
+		log.Printf("[CronJobDeleteRoutine] cronJobDeleteStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			cj, err := fd.cronJobDeleteStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] CronJobDelete stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.DeleteCronJob(cj); err != nil {
+				log.Printf("[MongoDB] DeleteCronJob error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully deleted cronjob event for: %s", cj.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// NodeAddRoutine Function
+func (fd *Feeder) NodeAddRoutine() {
+	if fd.nodeAddStream == nil {
+		log.Printf("[NodeAddRoutine] nodeAddStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			node, err := fd.nodeAddStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] NodeAdd stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.InsertNode(node); err != nil {
+				log.Printf("[MongoDB] InsertNode(Add) error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted node event for: %s", node.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// NodeUpdateRoutine Function
+func (fd *Feeder) NodeUpdateRoutine() {
+	if fd.nodeUpdateStream == nil {
+		log.Printf("[NodeUpdateRoutine] nodeUpdateStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			node, err := fd.nodeUpdateStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] NodeUpdate stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.UpdateNode(node); err != nil {
+				log.Printf("[MongoDB] UpdateNode error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully updated node event for: %s", node.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// NodeDeleteRoutine Function
+func (fd *Feeder) NodeDeleteRoutine() {
+	if fd.nodeDeleteStream == nil {
+		log.Printf("[NodeDeleteRoutine] nodeDeleteStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			node, err := fd.nodeDeleteStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] NodeDelete stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.DeleteNode(node); err != nil {
+				log.Printf("[MongoDB] DeleteNode error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully deleted node event for: %s", node.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// NamespaceAddRoutine Function
+func (fd *Feeder) NamespaceAddRoutine() {
+	if fd.namespaceAddStream == nil {
+		log.Printf("[NamespaceAddRoutine] namespaceAddStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ns, err := fd.namespaceAddStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] NamespaceAdd stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.InsertNamespace(ns); err != nil {
+				log.Printf("[MongoDB] InsertNamespace(Add) error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted namespace event for: %s", ns.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// NamespaceUpdateRoutine Function
+func (fd *Feeder) NamespaceUpdateRoutine() {
+	if fd.namespaceUpdateStream == nil {
+		log.Printf("[NamespaceUpdateRoutine] namespaceUpdateStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ns, err := fd.namespaceUpdateStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] NamespaceUpdate stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.UpdateNamespace(ns); err != nil {
+				log.Printf("[MongoDB] UpdateNamespace error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully updated namespace event for: %s", ns.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// NamespaceDeleteRoutine Function
+func (fd *Feeder) NamespaceDeleteRoutine() {
+	if fd.namespaceDeleteStream == nil {
+		log.Printf("[NamespaceDeleteRoutine] namespaceDeleteStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ns, err := fd.namespaceDeleteStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] NamespaceDelete stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.DeleteNamespace(ns); err != nil {
+				log.Printf("[MongoDB] DeleteNamespace error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully deleted namespace event for: %s", ns.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// IngressAddRoutine Function
+func (fd *Feeder) IngressAddRoutine() {
+	if fd.ingressAddStream == nil {
+		log.Printf("[IngressAddRoutine] ingressAddStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ing, err := fd.ingressAddStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] IngressAdd stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.InsertIngress(ing); err != nil {
+				log.Printf("[MongoDB] InsertIngress(Add) error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted ingress event for: %s", ing.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// IngressUpdateRoutine Function
+func (fd *Feeder) IngressUpdateRoutine() {
+	if fd.ingressUpdateStream == nil {
+		log.Printf("[IngressUpdateRoutine] ingressUpdateStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ing, err := fd.ingressUpdateStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] IngressUpdate stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.UpdateIngress(ing); err != nil {
+				log.Printf("[MongoDB] UpdateIngress error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully updated ingress event for: %s", ing.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// IngressDeleteRoutine Function
+func (fd *Feeder) IngressDeleteRoutine() {
+	if fd.ingressDeleteStream == nil {
+		log.Printf("[IngressDeleteRoutine] ingressDeleteStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			ing, err := fd.ingressDeleteStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] IngressDelete stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.DeleteIngress(ing); err != nil {
+				log.Printf("[MongoDB] DeleteIngress error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully deleted ingress event for: %s", ing.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// ServiceEndpointsAddRoutine Function
+func (fd *Feeder) ServiceEndpointsAddRoutine() {
+	if fd.serviceEndpointsAddStream == nil {
+		log.Printf("[ServiceEndpointsAddRoutine] serviceEndpointsAddStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			se, err := fd.serviceEndpointsAddStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] ServiceEndpointsAdd stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.InsertServiceEndpoints(se); err != nil {
+				log.Printf("[MongoDB] InsertServiceEndpoints(Add) error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully inserted service endpoints event for: %s", se.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// ServiceEndpointsUpdateRoutine Function
+func (fd *Feeder) ServiceEndpointsUpdateRoutine() {
+	if fd.serviceEndpointsUpdateStream == nil {
+		log.Printf("[ServiceEndpointsUpdateRoutine] serviceEndpointsUpdateStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			se, err := fd.serviceEndpointsUpdateStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] ServiceEndpointsUpdate stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.UpdateServiceEndpoints(se); err != nil {
+				log.Printf("[MongoDB] UpdateServiceEndpoints error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully updated service endpoints event for: %s", se.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
+
+// ServiceEndpointsDeleteRoutine Function
+func (fd *Feeder) ServiceEndpointsDeleteRoutine() {
+	if fd.serviceEndpointsDeleteStream == nil {
+		log.Printf("[ServiceEndpointsDeleteRoutine] serviceEndpointsDeleteStream is nil.")
+		return
+	}
+
+	for fd.Running {
+		select {
+		default:
+			se, err := fd.serviceEndpointsDeleteStream.Recv()
+			if err != nil {
+				log.Fatalf("[Client] ServiceEndpointsDelete stream ended: %v", err)
+				return
+			}
+			if err := fd.dbHandler.DeleteServiceEndpoints(se); err != nil {
+				log.Printf("[MongoDB] DeleteServiceEndpoints error: %v", err)
+			} else {
+				log.Printf("[MongoDB] Successfully deleted service endpoints event for: %s", se.Name)
+			}
+		case <-fd.Done:
+			return
+		}
+	}
+}
@@ -91,6 +91,18 @@ func main() {
 	if *logCfgPtr != "none" {
 		go logClient.APILogRoutine(*logCfgPtr)
 		log.Printf("[APILog] Started to watch API logs\n")
+
+		go logClient.ConnectionLogRoutine()
+		log.Printf("[ConnectionLog] Started to watch connection logs\n")
+
+		go logClient.CallTreeRoutine()
+		log.Printf("[CallTree] Started to watch call trees\n")
+
+		go logClient.AuditLogRoutine()
+		log.Printf("[AuditLog] Started to watch audit logs\n")
+
+		go logClient.ClusterNoticeRoutine()
+		log.Printf("[ClusterNotice] Started to watch cluster notices\n")
 	}
 
 	if *metricCfgPtr != "none" {
@@ -112,6 +124,38 @@ func main() {
 		go logClient.ServiceAddRoutine()
 		go logClient.ServiceUpdateRoutine()
 		go logClient.ServiceDeleteRoutine()
+
+		go logClient.StatefulSetAddRoutine()
+		go logClient.StatefulSetUpdateRoutine()
+		go logClient.StatefulSetDeleteRoutine()
+
+		go logClient.DaemonSetAddRoutine()
+		go logClient.DaemonSetUpdateRoutine()
+		go logClient.DaemonSetDeleteRoutine()
+
+		go logClient.JobAddRoutine()
+		go logClient.JobUpdateRoutine()
+		go logClient.JobDeleteRoutine()
+
+		go logClient.CronJobAddRoutine()
+		go logClient.CronJobUpdateRoutine()
+		go logClient.CronJobDeleteRoutine()
+
+		go logClient.NodeAddRoutine()
+		go logClient.NodeUpdateRoutine()
+		go logClient.NodeDeleteRoutine()
+
+		go logClient.NamespaceAddRoutine()
+		go logClient.NamespaceUpdateRoutine()
+		go logClient.NamespaceDeleteRoutine()
+
+		go logClient.IngressAddRoutine()
+		go logClient.IngressUpdateRoutine()
+		go logClient.IngressDeleteRoutine()
+
+		go logClient.ServiceEndpointsAddRoutine()
+		go logClient.ServiceEndpointsUpdateRoutine()
+		go logClient.ServiceEndpointsDeleteRoutine()
 		log.Printf("[ClusterInfo] Started to watch Cluster Information\n")
 	}
 
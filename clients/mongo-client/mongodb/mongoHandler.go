@@ -21,12 +21,24 @@ type DBHandler struct {
 	client *mongo.Client
 	cancel context.CancelFunc
 
-	database      *mongo.Database
-	deploys       *mongo.Collection
-	pods          *mongo.Collection
-	services      *mongo.Collection
-	apiLogCol     *mongo.Collection
-	evyMetricsCol *mongo.Collection
+	database         *mongo.Database
+	deploys          *mongo.Collection
+	pods             *mongo.Collection
+	services         *mongo.Collection
+	statefulSets     *mongo.Collection
+	daemonSets       *mongo.Collection
+	jobs             *mongo.Collection
+	cronJobs         *mongo.Collection
+	nodes            *mongo.Collection
+	namespaces       *mongo.Collection
+	ingresses        *mongo.Collection
+	serviceEndpoints *mongo.Collection
+	apiLogCol        *mongo.Collection
+	evyMetricsCol    *mongo.Collection
+	connLogCol       *mongo.Collection
+	callTreeCol      *mongo.Collection
+	auditLogCol      *mongo.Collection
+	noticeCol        *mongo.Collection
 }
 
 // dbHandler for Global Reference
@@ -61,8 +73,20 @@ func NewMongoDBHandler(mongoDBAddr string) (*DBHandler, error) {
 	dbHandler.deploys = dbHandler.database.Collection("Deploys")
 	dbHandler.pods = dbHandler.database.Collection("Pods")
 	dbHandler.services = dbHandler.database.Collection("Services")
+	dbHandler.statefulSets = dbHandler.database.Collection("StatefulSets")
+	dbHandler.daemonSets = dbHandler.database.Collection("DaemonSets")
+	dbHandler.jobs = dbHandler.database.Collection("Jobs")
+	dbHandler.cronJobs = dbHandler.database.Collection("CronJobs")
+	dbHandler.nodes = dbHandler.database.Collection("Nodes")
+	dbHandler.namespaces = dbHandler.database.Collection("Namespaces")
+	dbHandler.ingresses = dbHandler.database.Collection("Ingresses")
+	dbHandler.serviceEndpoints = dbHandler.database.Collection("ServiceEndpoints")
 	dbHandler.apiLogCol = dbHandler.database.Collection("APILogs")
 	dbHandler.evyMetricsCol = dbHandler.database.Collection("EnvoyMetrics")
+	dbHandler.connLogCol = dbHandler.database.Collection("ConnectionLogs")
+	dbHandler.callTreeCol = dbHandler.database.Collection("CallTrees")
+	dbHandler.auditLogCol = dbHandler.database.Collection("AuditLogs")
+	dbHandler.noticeCol = dbHandler.database.Collection("ClusterNotices")
 
 	return &dbHandler, nil
 }
@@ -81,6 +105,30 @@ func (handler *DBHandler) InsertAPILog(data *protobuf.APILog) error {
 	return err
 }
 
+// InsertConnectionLog Function
+func (handler *DBHandler) InsertConnectionLog(data *protobuf.ConnectionLog) error {
+	_, err := handler.connLogCol.InsertOne(context.Background(), data)
+	return err
+}
+
+// InsertClusterNotice Function
+func (handler *DBHandler) InsertClusterNotice(data *protobuf.ClusterNotice) error {
+	_, err := handler.noticeCol.InsertOne(context.Background(), data)
+	return err
+}
+
+// InsertAuditLog Function
+func (handler *DBHandler) InsertAuditLog(data *protobuf.AuditLog) error {
+	_, err := handler.auditLogCol.InsertOne(context.Background(), data)
+	return err
+}
+
+// InsertCallTree Function
+func (handler *DBHandler) InsertCallTree(data *protobuf.CallTree) error {
+	_, err := handler.callTreeCol.InsertOne(context.Background(), data)
+	return err
+}
+
 // InsertEnvoyMetrics Function
 func (handler *DBHandler) InsertEnvoyMetrics(data *protobuf.EnvoyMetrics) error {
 	_, err := handler.evyMetricsCol.InsertOne(context.Background(), data)
@@ -183,3 +231,257 @@ func (handler *DBHandler) DeleteService(svc *protobuf.Service) error {
 	_, err := handler.services.DeleteOne(context.Background(), filter)
 	return err
 }
+
+// InsertStatefulSet Function
+func (handler *DBHandler) InsertStatefulSet(sts *protobuf.StatefulSet) error {
+	_, err := handler.statefulSets.InsertOne(context.Background(), sts)
+	return err
+}
+
+// UpdateStatefulSet Function
+func (handler *DBHandler) UpdateStatefulSet(sts *protobuf.StatefulSet) error {
+	filter := bson.M{
+		"cluster":   sts.Cluster,
+		"namespace": sts.Namespace,
+		"name":      sts.Name,
+	}
+	update := bson.M{"$set": sts}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := handler.statefulSets.UpdateOne(context.Background(), filter, update, opts)
+	return err
+}
+
+// DeleteStatefulSet Function
+func (handler *DBHandler) DeleteStatefulSet(sts *protobuf.StatefulSet) error {
+	filter := bson.M{
+		"cluster":   sts.Cluster,
+		"namespace": sts.Namespace,
+		"name":      sts.Name,
+	}
+	_, err := handler.statefulSets.DeleteOne(context.Background(), filter)
+	return err
+}
+
+// InsertDaemonSet Function
+func (handler *DBHandler) InsertDaemonSet(ds *protobuf.DaemonSet) error {
+	_, err := handler.daemonSets.InsertOne(context.Background(), ds)
+	return err
+}
+
+// UpdateDaemonSet Function
+func (handler *DBHandler) UpdateDaemonSet(ds *protobuf.DaemonSet) error {
+	filter := bson.M{
+		"cluster":   ds.Cluster,
+		"namespace": ds.Namespace,
+		"name":      ds.Name,
+	}
+	update := bson.M{"$set": ds}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := handler.daemonSets.UpdateOne(context.Background(), filter, update, opts)
+	return err
+}
+
+// DeleteDaemonSet Function
+func (handler *DBHandler) DeleteDaemonSet(ds *protobuf.DaemonSet) error {
+	filter := bson.M{
+		"cluster":   ds.Cluster,
+		"namespace": ds.Namespace,
+		"name":      ds.Name,
+	}
+	_, err := handler.daemonSets.DeleteOne(context.Background(), filter)
+	return err
+}
+
+// InsertJob Function
+func (handler *DBHandler) InsertJob(job *protobuf.Job) error {
+	_, err := handler.jobs.InsertOne(context.Background(), job)
+	return err
+}
+
+// UpdateJob Function
+func (handler *DBHandler) UpdateJob(job *protobuf.Job) error {
+	filter := bson.M{
+		"cluster":   job.Cluster,
+		"namespace": job.Namespace,
+		"name":      job.Name,
+	}
+	update := bson.M{"$set": job}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := handler.jobs.UpdateOne(context.Background(), filter, update, opts)
+	return err
+}
+
+// DeleteJob Function
+func (handler *DBHandler) DeleteJob(job *protobuf.Job) error {
+	filter := bson.M{
+		"cluster":   job.Cluster,
+		"namespace": job.Namespace,
+		"name":      job.Name,
+	}
+	_, err := handler.jobs.DeleteOne(context.Background(), filter)
+	return err
+}
+
+// InsertCronJob Function
+func (handler *DBHandler) InsertCronJob(cj *protobuf.CronJob) error {
+	_, err := handler.cronJobs.InsertOne(context.Background(), cj)
+	return err
+}
+
+// UpdateCronJob Function
+func (handler *DBHandler) UpdateCronJob(cj *protobuf.CronJob) error {
+	filter := bson.M{
+		"cluster":   cj.Cluster,
+		"namespace": cj.Namespace,
+		"name":      cj.Name,
+	}
+	update := bson.M{"$set": cj}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := handler.cronJobs.UpdateOne(context.Background(), filter, update, opts)
+	return err
+}
+
+// DeleteCronJob Function
+func (handler *DBHandler) DeleteCronJob(cj *protobuf.CronJob) error {
+	filter := bson.M{
+		"cluster":   cj.Cluster,
+		"namespace": cj.Namespace,
+		"name":      cj.Name,
+	}
+	_, err := handler.cronJobs.DeleteOne(context.Background(), filter)
+	return err
+}
+
+// InsertNode Function
+func (handler *DBHandler) InsertNode(node *protobuf.Node) error {
+	_, err := handler.nodes.InsertOne(context.Background(), node)
+	return err
+}
+
+// UpdateNode Function
+func (handler *DBHandler) UpdateNode(node *protobuf.Node) error {
+	filter := bson.M{
+		"cluster": node.Cluster,
+		"name":    node.Name,
+	}
+	update := bson.M{"$set": node}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := handler.nodes.UpdateOne(context.Background(), filter, update, opts)
+	return err
+}
+
+// DeleteNode Function
+func (handler *DBHandler) DeleteNode(node *protobuf.Node) error {
+	filter := bson.M{
+		"cluster": node.Cluster,
+		"name":    node.Name,
+	}
+	_, err := handler.nodes.DeleteOne(context.Background(), filter)
+	return err
+}
+
+// InsertNamespace Function
+func (handler *DBHandler) InsertNamespace(ns *protobuf.Namespace) error {
+	_, err := handler.namespaces.InsertOne(context.Background(), ns)
+	return err
+}
+
+// UpdateNamespace Function
+func (handler *DBHandler) UpdateNamespace(ns *protobuf.Namespace) error {
+	filter := bson.M{
+		"cluster": ns.Cluster,
+		"name":    ns.Name,
+	}
+	update := bson.M{"$set": ns}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := handler.namespaces.UpdateOne(context.Background(), filter, update, opts)
+	return err
+}
+
+// DeleteNamespace Function
+func (handler *DBHandler) DeleteNamespace(ns *protobuf.Namespace) error {
+	filter := bson.M{
+		"cluster": ns.Cluster,
+		"name":    ns.Name,
+	}
+	_, err := handler.namespaces.DeleteOne(context.Background(), filter)
+	return err
+}
+
+// InsertIngress Function
+func (handler *DBHandler) InsertIngress(ing *protobuf.Ingress) error {
+	_, err := handler.ingresses.InsertOne(context.Background(), ing)
+	return err
+}
+
+// UpdateIngress Function
+func (handler *DBHandler) UpdateIngress(ing *protobuf.Ingress) error {
+	filter := bson.M{
+		"cluster":   ing.Cluster,
+		"namespace": ing.Namespace,
+		"name":      ing.Name,
+		"kind":      ing.Kind,
+	}
+	update := bson.M{"$set": ing}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := handler.ingresses.UpdateOne(context.Background(), filter, update, opts)
+	return err
+}
+
+// DeleteIngress Function
+func (handler *DBHandler) DeleteIngress(ing *protobuf.Ingress) error {
+	filter := bson.M{
+		"cluster":   ing.Cluster,
+		"namespace": ing.Namespace,
+		"name":      ing.Name,
+		"kind":      ing.Kind,
+	}
+	_, err := handler.ingresses.DeleteOne(context.Background(), filter)
+	return err
+}
+
+// InsertServiceEndpoints Function
+func (handler *DBHandler) InsertServiceEndpoints(se *protobuf.ServiceEndpoints) error {
+	_, err := handler.serviceEndpoints.InsertOne(context.Background(), se)
+	return err
+}
+
+// UpdateServiceEndpoints Function
+func (handler *DBHandler) UpdateServiceEndpoints(se *protobuf.ServiceEndpoints) error {
+	filter := bson.M{
+		"cluster":   se.Cluster,
+		"namespace": se.Namespace,
+		"name":      se.Name,
+	}
+	update := bson.M{"$set": se}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := handler.serviceEndpoints.UpdateOne(context.Background(), filter, update, opts)
+	return err
+}
+
+// DeleteServiceEndpoints Function
+func (handler *DBHandler) DeleteServiceEndpoints(se *protobuf.ServiceEndpoints) error {
+	filter := bson.M{
+		"cluster":   se.Cluster,
+		"namespace": se.Namespace,
+		"name":      se.Name,
+	}
+	_, err := handler.serviceEndpoints.DeleteOne(context.Background(), filter)
+	return err
+}
@@ -19,28 +19,90 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SentryFlow_GetAPILog_FullMethodName           = "/protobuf.SentryFlow/GetAPILog"
-	SentryFlow_GetEnvoyMetrics_FullMethodName     = "/protobuf.SentryFlow/GetEnvoyMetrics"
-	SentryFlow_AddDeployEventDB_FullMethodName    = "/protobuf.SentryFlow/AddDeployEventDB"
-	SentryFlow_UpdateDeployEventDB_FullMethodName = "/protobuf.SentryFlow/UpdateDeployEventDB"
-	SentryFlow_DeleteDeployEventDB_FullMethodName = "/protobuf.SentryFlow/DeleteDeployEventDB"
-	SentryFlow_AddPodEventDB_FullMethodName       = "/protobuf.SentryFlow/AddPodEventDB"
-	SentryFlow_UpdatePodEventDB_FullMethodName    = "/protobuf.SentryFlow/UpdatePodEventDB"
-	SentryFlow_DeletePodEventDB_FullMethodName    = "/protobuf.SentryFlow/DeletePodEventDB"
-	SentryFlow_AddSvcEventDB_FullMethodName       = "/protobuf.SentryFlow/AddSvcEventDB"
-	SentryFlow_UpdateSvcEventDB_FullMethodName    = "/protobuf.SentryFlow/UpdateSvcEventDB"
-	SentryFlow_DeleteSvcEventDB_FullMethodName    = "/protobuf.SentryFlow/DeleteSvcEventDB"
-	SentryFlow_GiveAPILog_FullMethodName          = "/protobuf.SentryFlow/GiveAPILog"
-	SentryFlow_GiveEnvoyMetrics_FullMethodName    = "/protobuf.SentryFlow/GiveEnvoyMetrics"
-	SentryFlow_AddDeployEvent_FullMethodName      = "/protobuf.SentryFlow/AddDeployEvent"
-	SentryFlow_UpdateDeployEvent_FullMethodName   = "/protobuf.SentryFlow/UpdateDeployEvent"
-	SentryFlow_DeleteDeployEvent_FullMethodName   = "/protobuf.SentryFlow/DeleteDeployEvent"
-	SentryFlow_AddPodEvent_FullMethodName         = "/protobuf.SentryFlow/AddPodEvent"
-	SentryFlow_UpdatePodEvent_FullMethodName      = "/protobuf.SentryFlow/UpdatePodEvent"
-	SentryFlow_DeletePodEvent_FullMethodName      = "/protobuf.SentryFlow/DeletePodEvent"
-	SentryFlow_AddSvcEvent_FullMethodName         = "/protobuf.SentryFlow/AddSvcEvent"
-	SentryFlow_UpdateSvcEvent_FullMethodName      = "/protobuf.SentryFlow/UpdateSvcEvent"
-	SentryFlow_DeleteSvcEvent_FullMethodName      = "/protobuf.SentryFlow/DeleteSvcEvent"
+	SentryFlow_GetAPILog_FullMethodName                     = "/protobuf.SentryFlow/GetAPILog"
+	SentryFlow_GetEnvoyMetrics_FullMethodName               = "/protobuf.SentryFlow/GetEnvoyMetrics"
+	SentryFlow_GetConnectionLog_FullMethodName              = "/protobuf.SentryFlow/GetConnectionLog"
+	SentryFlow_GetClusterNotice_FullMethodName              = "/protobuf.SentryFlow/GetClusterNotice"
+	SentryFlow_GetAuditLog_FullMethodName                   = "/protobuf.SentryFlow/GetAuditLog"
+	SentryFlow_ListAgents_FullMethodName                    = "/protobuf.SentryFlow/ListAgents"
+	SentryFlow_GetCallTree_FullMethodName                   = "/protobuf.SentryFlow/GetCallTree"
+	SentryFlow_QueryAPILogs_FullMethodName                  = "/protobuf.SentryFlow/QueryAPILogs"
+	SentryFlow_GetServiceGraph_FullMethodName               = "/protobuf.SentryFlow/GetServiceGraph"
+	SentryFlow_WatchServiceGraph_FullMethodName             = "/protobuf.SentryFlow/WatchServiceGraph"
+	SentryFlow_AddDeployEventDB_FullMethodName              = "/protobuf.SentryFlow/AddDeployEventDB"
+	SentryFlow_UpdateDeployEventDB_FullMethodName           = "/protobuf.SentryFlow/UpdateDeployEventDB"
+	SentryFlow_DeleteDeployEventDB_FullMethodName           = "/protobuf.SentryFlow/DeleteDeployEventDB"
+	SentryFlow_AddPodEventDB_FullMethodName                 = "/protobuf.SentryFlow/AddPodEventDB"
+	SentryFlow_UpdatePodEventDB_FullMethodName              = "/protobuf.SentryFlow/UpdatePodEventDB"
+	SentryFlow_DeletePodEventDB_FullMethodName              = "/protobuf.SentryFlow/DeletePodEventDB"
+	SentryFlow_AddSvcEventDB_FullMethodName                 = "/protobuf.SentryFlow/AddSvcEventDB"
+	SentryFlow_UpdateSvcEventDB_FullMethodName              = "/protobuf.SentryFlow/UpdateSvcEventDB"
+	SentryFlow_DeleteSvcEventDB_FullMethodName              = "/protobuf.SentryFlow/DeleteSvcEventDB"
+	SentryFlow_AddStatefulSetEventDB_FullMethodName         = "/protobuf.SentryFlow/AddStatefulSetEventDB"
+	SentryFlow_UpdateStatefulSetEventDB_FullMethodName      = "/protobuf.SentryFlow/UpdateStatefulSetEventDB"
+	SentryFlow_DeleteStatefulSetEventDB_FullMethodName      = "/protobuf.SentryFlow/DeleteStatefulSetEventDB"
+	SentryFlow_AddDaemonSetEventDB_FullMethodName           = "/protobuf.SentryFlow/AddDaemonSetEventDB"
+	SentryFlow_UpdateDaemonSetEventDB_FullMethodName        = "/protobuf.SentryFlow/UpdateDaemonSetEventDB"
+	SentryFlow_DeleteDaemonSetEventDB_FullMethodName        = "/protobuf.SentryFlow/DeleteDaemonSetEventDB"
+	SentryFlow_AddJobEventDB_FullMethodName                 = "/protobuf.SentryFlow/AddJobEventDB"
+	SentryFlow_UpdateJobEventDB_FullMethodName              = "/protobuf.SentryFlow/UpdateJobEventDB"
+	SentryFlow_DeleteJobEventDB_FullMethodName              = "/protobuf.SentryFlow/DeleteJobEventDB"
+	SentryFlow_AddCronJobEventDB_FullMethodName             = "/protobuf.SentryFlow/AddCronJobEventDB"
+	SentryFlow_UpdateCronJobEventDB_FullMethodName          = "/protobuf.SentryFlow/UpdateCronJobEventDB"
+	SentryFlow_DeleteCronJobEventDB_FullMethodName          = "/protobuf.SentryFlow/DeleteCronJobEventDB"
+	SentryFlow_AddNodeEventDB_FullMethodName                = "/protobuf.SentryFlow/AddNodeEventDB"
+	SentryFlow_UpdateNodeEventDB_FullMethodName             = "/protobuf.SentryFlow/UpdateNodeEventDB"
+	SentryFlow_DeleteNodeEventDB_FullMethodName             = "/protobuf.SentryFlow/DeleteNodeEventDB"
+	SentryFlow_AddNamespaceEventDB_FullMethodName           = "/protobuf.SentryFlow/AddNamespaceEventDB"
+	SentryFlow_UpdateNamespaceEventDB_FullMethodName        = "/protobuf.SentryFlow/UpdateNamespaceEventDB"
+	SentryFlow_DeleteNamespaceEventDB_FullMethodName        = "/protobuf.SentryFlow/DeleteNamespaceEventDB"
+	SentryFlow_AddIngressEventDB_FullMethodName             = "/protobuf.SentryFlow/AddIngressEventDB"
+	SentryFlow_UpdateIngressEventDB_FullMethodName          = "/protobuf.SentryFlow/UpdateIngressEventDB"
+	SentryFlow_DeleteIngressEventDB_FullMethodName          = "/protobuf.SentryFlow/DeleteIngressEventDB"
+	SentryFlow_AddServiceEndpointsEventDB_FullMethodName    = "/protobuf.SentryFlow/AddServiceEndpointsEventDB"
+	SentryFlow_UpdateServiceEndpointsEventDB_FullMethodName = "/protobuf.SentryFlow/UpdateServiceEndpointsEventDB"
+	SentryFlow_DeleteServiceEndpointsEventDB_FullMethodName = "/protobuf.SentryFlow/DeleteServiceEndpointsEventDB"
+	SentryFlow_GiveAPILog_FullMethodName                    = "/protobuf.SentryFlow/GiveAPILog"
+	SentryFlow_GiveEnvoyMetrics_FullMethodName              = "/protobuf.SentryFlow/GiveEnvoyMetrics"
+	SentryFlow_GiveConnectionLog_FullMethodName             = "/protobuf.SentryFlow/GiveConnectionLog"
+	SentryFlow_GiveClusterNotice_FullMethodName             = "/protobuf.SentryFlow/GiveClusterNotice"
+	SentryFlow_GiveAuditLog_FullMethodName                  = "/protobuf.SentryFlow/GiveAuditLog"
+	SentryFlow_RegisterAgent_FullMethodName                 = "/protobuf.SentryFlow/RegisterAgent"
+	SentryFlow_Heartbeat_FullMethodName                     = "/protobuf.SentryFlow/Heartbeat"
+	SentryFlow_AddDeployEvent_FullMethodName                = "/protobuf.SentryFlow/AddDeployEvent"
+	SentryFlow_UpdateDeployEvent_FullMethodName             = "/protobuf.SentryFlow/UpdateDeployEvent"
+	SentryFlow_DeleteDeployEvent_FullMethodName             = "/protobuf.SentryFlow/DeleteDeployEvent"
+	SentryFlow_AddPodEvent_FullMethodName                   = "/protobuf.SentryFlow/AddPodEvent"
+	SentryFlow_UpdatePodEvent_FullMethodName                = "/protobuf.SentryFlow/UpdatePodEvent"
+	SentryFlow_DeletePodEvent_FullMethodName                = "/protobuf.SentryFlow/DeletePodEvent"
+	SentryFlow_AddSvcEvent_FullMethodName                   = "/protobuf.SentryFlow/AddSvcEvent"
+	SentryFlow_UpdateSvcEvent_FullMethodName                = "/protobuf.SentryFlow/UpdateSvcEvent"
+	SentryFlow_DeleteSvcEvent_FullMethodName                = "/protobuf.SentryFlow/DeleteSvcEvent"
+	SentryFlow_AddStatefulSetEvent_FullMethodName           = "/protobuf.SentryFlow/AddStatefulSetEvent"
+	SentryFlow_UpdateStatefulSetEvent_FullMethodName        = "/protobuf.SentryFlow/UpdateStatefulSetEvent"
+	SentryFlow_DeleteStatefulSetEvent_FullMethodName        = "/protobuf.SentryFlow/DeleteStatefulSetEvent"
+	SentryFlow_AddDaemonSetEvent_FullMethodName             = "/protobuf.SentryFlow/AddDaemonSetEvent"
+	SentryFlow_UpdateDaemonSetEvent_FullMethodName          = "/protobuf.SentryFlow/UpdateDaemonSetEvent"
+	SentryFlow_DeleteDaemonSetEvent_FullMethodName          = "/protobuf.SentryFlow/DeleteDaemonSetEvent"
+	SentryFlow_AddJobEvent_FullMethodName                   = "/protobuf.SentryFlow/AddJobEvent"
+	SentryFlow_UpdateJobEvent_FullMethodName                = "/protobuf.SentryFlow/UpdateJobEvent"
+	SentryFlow_DeleteJobEvent_FullMethodName                = "/protobuf.SentryFlow/DeleteJobEvent"
+	SentryFlow_AddCronJobEvent_FullMethodName               = "/protobuf.SentryFlow/AddCronJobEvent"
+	SentryFlow_UpdateCronJobEvent_FullMethodName            = "/protobuf.SentryFlow/UpdateCronJobEvent"
+	SentryFlow_DeleteCronJobEvent_FullMethodName            = "/protobuf.SentryFlow/DeleteCronJobEvent"
+	SentryFlow_AddNodeEvent_FullMethodName                  = "/protobuf.SentryFlow/AddNodeEvent"
+	SentryFlow_UpdateNodeEvent_FullMethodName               = "/protobuf.SentryFlow/UpdateNodeEvent"
+	SentryFlow_DeleteNodeEvent_FullMethodName               = "/protobuf.SentryFlow/DeleteNodeEvent"
+	SentryFlow_AddNamespaceEvent_FullMethodName             = "/protobuf.SentryFlow/AddNamespaceEvent"
+	SentryFlow_UpdateNamespaceEvent_FullMethodName          = "/protobuf.SentryFlow/UpdateNamespaceEvent"
+	SentryFlow_DeleteNamespaceEvent_FullMethodName          = "/protobuf.SentryFlow/DeleteNamespaceEvent"
+	SentryFlow_AddIngressEvent_FullMethodName               = "/protobuf.SentryFlow/AddIngressEvent"
+	SentryFlow_UpdateIngressEvent_FullMethodName            = "/protobuf.SentryFlow/UpdateIngressEvent"
+	SentryFlow_DeleteIngressEvent_FullMethodName            = "/protobuf.SentryFlow/DeleteIngressEvent"
+	SentryFlow_AddServiceEndpointsEvent_FullMethodName      = "/protobuf.SentryFlow/AddServiceEndpointsEvent"
+	SentryFlow_UpdateServiceEndpointsEvent_FullMethodName   = "/protobuf.SentryFlow/UpdateServiceEndpointsEvent"
+	SentryFlow_DeleteServiceEndpointsEvent_FullMethodName   = "/protobuf.SentryFlow/DeleteServiceEndpointsEvent"
+	SentryFlow_SyncClusterSnapshot_FullMethodName           = "/protobuf.SentryFlow/SyncClusterSnapshot"
 )
 
 // SentryFlowClient is the client API for SentryFlow service.
@@ -50,6 +112,14 @@ type SentryFlowClient interface {
 	// operator -> client
 	GetAPILog(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[APILog], error)
 	GetEnvoyMetrics(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EnvoyMetrics], error)
+	GetConnectionLog(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConnectionLog], error)
+	GetClusterNotice(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClusterNotice], error)
+	GetAuditLog(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditLog], error)
+	ListAgents(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*AgentList, error)
+	GetCallTree(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CallTree], error)
+	QueryAPILogs(ctx context.Context, in *APILogQuery, opts ...grpc.CallOption) (*APILogPage, error)
+	GetServiceGraph(ctx context.Context, in *ServiceGraphQuery, opts ...grpc.CallOption) (*ServiceGraph, error)
+	WatchServiceGraph(ctx context.Context, in *ServiceGraphQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServiceGraphChange], error)
 	AddDeployEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Deploy], error)
 	UpdateDeployEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Deploy], error)
 	DeleteDeployEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Deploy], error)
@@ -59,9 +129,38 @@ type SentryFlowClient interface {
 	AddSvcEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Service], error)
 	UpdateSvcEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Service], error)
 	DeleteSvcEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Service], error)
+	AddStatefulSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatefulSet], error)
+	UpdateStatefulSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatefulSet], error)
+	DeleteStatefulSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatefulSet], error)
+	AddDaemonSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DaemonSet], error)
+	UpdateDaemonSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DaemonSet], error)
+	DeleteDaemonSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DaemonSet], error)
+	AddJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Job], error)
+	UpdateJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Job], error)
+	DeleteJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Job], error)
+	AddCronJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CronJob], error)
+	UpdateCronJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CronJob], error)
+	DeleteCronJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CronJob], error)
+	AddNodeEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Node], error)
+	UpdateNodeEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Node], error)
+	DeleteNodeEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Node], error)
+	AddNamespaceEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Namespace], error)
+	UpdateNamespaceEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Namespace], error)
+	DeleteNamespaceEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Namespace], error)
+	AddIngressEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ingress], error)
+	UpdateIngressEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ingress], error)
+	DeleteIngressEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ingress], error)
+	AddServiceEndpointsEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServiceEndpoints], error)
+	UpdateServiceEndpointsEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServiceEndpoints], error)
+	DeleteServiceEndpointsEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServiceEndpoints], error)
 	// agent -> operator
 	GiveAPILog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[APILog, Response], error)
 	GiveEnvoyMetrics(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[EnvoyMetrics, Response], error)
+	GiveConnectionLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ConnectionLog, Response], error)
+	GiveClusterNotice(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ClusterNotice, Response], error)
+	GiveAuditLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AuditLog, Response], error)
+	RegisterAgent(ctx context.Context, in *AgentInfo, opts ...grpc.CallOption) (*Response, error)
+	Heartbeat(ctx context.Context, in *AgentHeartbeat, opts ...grpc.CallOption) (*Response, error)
 	AddDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error)
 	UpdateDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error)
 	DeleteDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error)
@@ -71,6 +170,31 @@ type SentryFlowClient interface {
 	AddSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error)
 	UpdateSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error)
 	DeleteSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error)
+	AddStatefulSetEvent(ctx context.Context, in *StatefulSet, opts ...grpc.CallOption) (*Response, error)
+	UpdateStatefulSetEvent(ctx context.Context, in *StatefulSet, opts ...grpc.CallOption) (*Response, error)
+	DeleteStatefulSetEvent(ctx context.Context, in *StatefulSet, opts ...grpc.CallOption) (*Response, error)
+	AddDaemonSetEvent(ctx context.Context, in *DaemonSet, opts ...grpc.CallOption) (*Response, error)
+	UpdateDaemonSetEvent(ctx context.Context, in *DaemonSet, opts ...grpc.CallOption) (*Response, error)
+	DeleteDaemonSetEvent(ctx context.Context, in *DaemonSet, opts ...grpc.CallOption) (*Response, error)
+	AddJobEvent(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Response, error)
+	UpdateJobEvent(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Response, error)
+	DeleteJobEvent(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Response, error)
+	AddCronJobEvent(ctx context.Context, in *CronJob, opts ...grpc.CallOption) (*Response, error)
+	UpdateCronJobEvent(ctx context.Context, in *CronJob, opts ...grpc.CallOption) (*Response, error)
+	DeleteCronJobEvent(ctx context.Context, in *CronJob, opts ...grpc.CallOption) (*Response, error)
+	AddNodeEvent(ctx context.Context, in *Node, opts ...grpc.CallOption) (*Response, error)
+	UpdateNodeEvent(ctx context.Context, in *Node, opts ...grpc.CallOption) (*Response, error)
+	DeleteNodeEvent(ctx context.Context, in *Node, opts ...grpc.CallOption) (*Response, error)
+	AddNamespaceEvent(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Response, error)
+	UpdateNamespaceEvent(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Response, error)
+	DeleteNamespaceEvent(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Response, error)
+	AddIngressEvent(ctx context.Context, in *Ingress, opts ...grpc.CallOption) (*Response, error)
+	UpdateIngressEvent(ctx context.Context, in *Ingress, opts ...grpc.CallOption) (*Response, error)
+	DeleteIngressEvent(ctx context.Context, in *Ingress, opts ...grpc.CallOption) (*Response, error)
+	AddServiceEndpointsEvent(ctx context.Context, in *ServiceEndpoints, opts ...grpc.CallOption) (*Response, error)
+	UpdateServiceEndpointsEvent(ctx context.Context, in *ServiceEndpoints, opts ...grpc.CallOption) (*Response, error)
+	DeleteServiceEndpointsEvent(ctx context.Context, in *ServiceEndpoints, opts ...grpc.CallOption) (*Response, error)
+	SyncClusterSnapshot(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ClusterSnapshot, Response], error)
 }
 
 type sentryFlowClient struct {
@@ -119,9 +243,134 @@ func (c *sentryFlowClient) GetEnvoyMetrics(ctx context.Context, in *ClientInfo,
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type SentryFlow_GetEnvoyMetricsClient = grpc.ServerStreamingClient[EnvoyMetrics]
 
+func (c *sentryFlowClient) GetConnectionLog(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConnectionLog], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[2], SentryFlow_GetConnectionLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClientInfo, ConnectionLog]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetConnectionLogClient = grpc.ServerStreamingClient[ConnectionLog]
+
+func (c *sentryFlowClient) GetClusterNotice(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClusterNotice], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[3], SentryFlow_GetClusterNotice_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClientInfo, ClusterNotice]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetClusterNoticeClient = grpc.ServerStreamingClient[ClusterNotice]
+
+func (c *sentryFlowClient) GetAuditLog(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditLog], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[4], SentryFlow_GetAuditLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClientInfo, AuditLog]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetAuditLogClient = grpc.ServerStreamingClient[AuditLog]
+
+func (c *sentryFlowClient) ListAgents(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*AgentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentList)
+	err := c.cc.Invoke(ctx, SentryFlow_ListAgents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) GetCallTree(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CallTree], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[5], SentryFlow_GetCallTree_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClientInfo, CallTree]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetCallTreeClient = grpc.ServerStreamingClient[CallTree]
+
+func (c *sentryFlowClient) QueryAPILogs(ctx context.Context, in *APILogQuery, opts ...grpc.CallOption) (*APILogPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APILogPage)
+	err := c.cc.Invoke(ctx, SentryFlow_QueryAPILogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) GetServiceGraph(ctx context.Context, in *ServiceGraphQuery, opts ...grpc.CallOption) (*ServiceGraph, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceGraph)
+	err := c.cc.Invoke(ctx, SentryFlow_GetServiceGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) WatchServiceGraph(ctx context.Context, in *ServiceGraphQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServiceGraphChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[6], SentryFlow_WatchServiceGraph_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServiceGraphQuery, ServiceGraphChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_WatchServiceGraphClient = grpc.ServerStreamingClient[ServiceGraphChange]
+
 func (c *sentryFlowClient) AddDeployEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Deploy], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[2], SentryFlow_AddDeployEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[7], SentryFlow_AddDeployEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +389,7 @@ type SentryFlow_AddDeployEventDBClient = grpc.ServerStreamingClient[Deploy]
 
 func (c *sentryFlowClient) UpdateDeployEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Deploy], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[3], SentryFlow_UpdateDeployEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[8], SentryFlow_UpdateDeployEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +408,7 @@ type SentryFlow_UpdateDeployEventDBClient = grpc.ServerStreamingClient[Deploy]
 
 func (c *sentryFlowClient) DeleteDeployEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Deploy], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[4], SentryFlow_DeleteDeployEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[9], SentryFlow_DeleteDeployEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -178,7 +427,7 @@ type SentryFlow_DeleteDeployEventDBClient = grpc.ServerStreamingClient[Deploy]
 
 func (c *sentryFlowClient) AddPodEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pod], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[5], SentryFlow_AddPodEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[10], SentryFlow_AddPodEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +446,7 @@ type SentryFlow_AddPodEventDBClient = grpc.ServerStreamingClient[Pod]
 
 func (c *sentryFlowClient) UpdatePodEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pod], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[6], SentryFlow_UpdatePodEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[11], SentryFlow_UpdatePodEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +465,7 @@ type SentryFlow_UpdatePodEventDBClient = grpc.ServerStreamingClient[Pod]
 
 func (c *sentryFlowClient) DeletePodEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pod], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[7], SentryFlow_DeletePodEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[12], SentryFlow_DeletePodEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +484,7 @@ type SentryFlow_DeletePodEventDBClient = grpc.ServerStreamingClient[Pod]
 
 func (c *sentryFlowClient) AddSvcEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Service], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[8], SentryFlow_AddSvcEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[13], SentryFlow_AddSvcEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -254,7 +503,7 @@ type SentryFlow_AddSvcEventDBClient = grpc.ServerStreamingClient[Service]
 
 func (c *sentryFlowClient) UpdateSvcEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Service], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[9], SentryFlow_UpdateSvcEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[14], SentryFlow_UpdateSvcEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -273,7 +522,7 @@ type SentryFlow_UpdateSvcEventDBClient = grpc.ServerStreamingClient[Service]
 
 func (c *sentryFlowClient) DeleteSvcEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Service], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[10], SentryFlow_DeleteSvcEventDB_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[15], SentryFlow_DeleteSvcEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -290,542 +539,2427 @@ func (c *sentryFlowClient) DeleteSvcEventDB(ctx context.Context, in *ClientInfo,
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type SentryFlow_DeleteSvcEventDBClient = grpc.ServerStreamingClient[Service]
 
-func (c *sentryFlowClient) GiveAPILog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[APILog, Response], error) {
+func (c *sentryFlowClient) AddStatefulSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatefulSet], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[11], SentryFlow_GiveAPILog_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[16], SentryFlow_AddStatefulSetEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[APILog, Response]{ClientStream: stream}
+	x := &grpc.GenericClientStream[ClientInfo, StatefulSet]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_GiveAPILogClient = grpc.ClientStreamingClient[APILog, Response]
+type SentryFlow_AddStatefulSetEventDBClient = grpc.ServerStreamingClient[StatefulSet]
 
-func (c *sentryFlowClient) GiveEnvoyMetrics(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[EnvoyMetrics, Response], error) {
+func (c *sentryFlowClient) UpdateStatefulSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatefulSet], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[12], SentryFlow_GiveEnvoyMetrics_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[17], SentryFlow_UpdateStatefulSetEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[EnvoyMetrics, Response]{ClientStream: stream}
+	x := &grpc.GenericClientStream[ClientInfo, StatefulSet]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_GiveEnvoyMetricsClient = grpc.ClientStreamingClient[EnvoyMetrics, Response]
+type SentryFlow_UpdateStatefulSetEventDBClient = grpc.ServerStreamingClient[StatefulSet]
 
-func (c *sentryFlowClient) AddDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error) {
+func (c *sentryFlowClient) DeleteStatefulSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatefulSet], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_AddDeployEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[18], SentryFlow_DeleteStatefulSetEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, StatefulSet]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sentryFlowClient) UpdateDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteStatefulSetEventDBClient = grpc.ServerStreamingClient[StatefulSet]
+
+func (c *sentryFlowClient) AddDaemonSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DaemonSet], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_UpdateDeployEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[19], SentryFlow_AddDaemonSetEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, DaemonSet]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sentryFlowClient) DeleteDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddDaemonSetEventDBClient = grpc.ServerStreamingClient[DaemonSet]
+
+func (c *sentryFlowClient) UpdateDaemonSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DaemonSet], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_DeleteDeployEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[20], SentryFlow_UpdateDaemonSetEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, DaemonSet]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sentryFlowClient) AddPodEvent(ctx context.Context, in *Pod, opts ...grpc.CallOption) (*Response, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateDaemonSetEventDBClient = grpc.ServerStreamingClient[DaemonSet]
+
+func (c *sentryFlowClient) DeleteDaemonSetEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DaemonSet], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_AddPodEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[21], SentryFlow_DeleteDaemonSetEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, DaemonSet]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sentryFlowClient) UpdatePodEvent(ctx context.Context, in *Pod, opts ...grpc.CallOption) (*Response, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteDaemonSetEventDBClient = grpc.ServerStreamingClient[DaemonSet]
+
+func (c *sentryFlowClient) AddJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Job], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_UpdatePodEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[22], SentryFlow_AddJobEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, Job]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sentryFlowClient) DeletePodEvent(ctx context.Context, in *Pod, opts ...grpc.CallOption) (*Response, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddJobEventDBClient = grpc.ServerStreamingClient[Job]
+
+func (c *sentryFlowClient) UpdateJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Job], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_DeletePodEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[23], SentryFlow_UpdateJobEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, Job]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sentryFlowClient) AddSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateJobEventDBClient = grpc.ServerStreamingClient[Job]
+
+func (c *sentryFlowClient) DeleteJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Job], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_AddSvcEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[24], SentryFlow_DeleteJobEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, Job]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sentryFlowClient) UpdateSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteJobEventDBClient = grpc.ServerStreamingClient[Job]
+
+func (c *sentryFlowClient) AddCronJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CronJob], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_UpdateSvcEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[25], SentryFlow_AddCronJobEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, CronJob]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sentryFlowClient) DeleteSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddCronJobEventDBClient = grpc.ServerStreamingClient[CronJob]
+
+func (c *sentryFlowClient) UpdateCronJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CronJob], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, SentryFlow_DeleteSvcEvent_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[26], SentryFlow_UpdateCronJobEventDB_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ClientInfo, CronJob]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// SentryFlowServer is the server API for SentryFlow service.
-// All implementations should embed UnimplementedSentryFlowServer
-// for forward compatibility.
-type SentryFlowServer interface {
-	// operator -> client
-	GetAPILog(*ClientInfo, grpc.ServerStreamingServer[APILog]) error
-	GetEnvoyMetrics(*ClientInfo, grpc.ServerStreamingServer[EnvoyMetrics]) error
-	AddDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error
-	UpdateDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error
-	DeleteDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error
-	AddPodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error
-	UpdatePodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error
-	DeletePodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error
-	AddSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error
-	UpdateSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error
-	DeleteSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error
-	// agent -> operator
-	GiveAPILog(grpc.ClientStreamingServer[APILog, Response]) error
-	GiveEnvoyMetrics(grpc.ClientStreamingServer[EnvoyMetrics, Response]) error
-	AddDeployEvent(context.Context, *Deploy) (*Response, error)
-	UpdateDeployEvent(context.Context, *Deploy) (*Response, error)
-	DeleteDeployEvent(context.Context, *Deploy) (*Response, error)
-	AddPodEvent(context.Context, *Pod) (*Response, error)
-	UpdatePodEvent(context.Context, *Pod) (*Response, error)
-	DeletePodEvent(context.Context, *Pod) (*Response, error)
-	AddSvcEvent(context.Context, *Service) (*Response, error)
-	UpdateSvcEvent(context.Context, *Service) (*Response, error)
-	DeleteSvcEvent(context.Context, *Service) (*Response, error)
-}
-
-// UnimplementedSentryFlowServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSentryFlowServer struct{}
-
-func (UnimplementedSentryFlowServer) GetAPILog(*ClientInfo, grpc.ServerStreamingServer[APILog]) error {
-	return status.Errorf(codes.Unimplemented, "method GetAPILog not implemented")
-}
-func (UnimplementedSentryFlowServer) GetEnvoyMetrics(*ClientInfo, grpc.ServerStreamingServer[EnvoyMetrics]) error {
-	return status.Errorf(codes.Unimplemented, "method GetEnvoyMetrics not implemented")
-}
-func (UnimplementedSentryFlowServer) AddDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error {
-	return status.Errorf(codes.Unimplemented, "method AddDeployEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) UpdateDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error {
-	return status.Errorf(codes.Unimplemented, "method UpdateDeployEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) DeleteDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error {
-	return status.Errorf(codes.Unimplemented, "method DeleteDeployEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) AddPodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error {
-	return status.Errorf(codes.Unimplemented, "method AddPodEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) UpdatePodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error {
-	return status.Errorf(codes.Unimplemented, "method UpdatePodEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) DeletePodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error {
-	return status.Errorf(codes.Unimplemented, "method DeletePodEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) AddSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error {
-	return status.Errorf(codes.Unimplemented, "method AddSvcEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) UpdateSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error {
-	return status.Errorf(codes.Unimplemented, "method UpdateSvcEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) DeleteSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error {
-	return status.Errorf(codes.Unimplemented, "method DeleteSvcEventDB not implemented")
-}
-func (UnimplementedSentryFlowServer) GiveAPILog(grpc.ClientStreamingServer[APILog, Response]) error {
-	return status.Errorf(codes.Unimplemented, "method GiveAPILog not implemented")
-}
-func (UnimplementedSentryFlowServer) GiveEnvoyMetrics(grpc.ClientStreamingServer[EnvoyMetrics, Response]) error {
-	return status.Errorf(codes.Unimplemented, "method GiveEnvoyMetrics not implemented")
-}
-func (UnimplementedSentryFlowServer) AddDeployEvent(context.Context, *Deploy) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDeployEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) UpdateDeployEvent(context.Context, *Deploy) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeployEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) DeleteDeployEvent(context.Context, *Deploy) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeployEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) AddPodEvent(context.Context, *Pod) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPodEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) UpdatePodEvent(context.Context, *Pod) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePodEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) DeletePodEvent(context.Context, *Pod) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePodEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) AddSvcEvent(context.Context, *Service) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSvcEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) UpdateSvcEvent(context.Context, *Service) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSvcEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) DeleteSvcEvent(context.Context, *Service) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSvcEvent not implemented")
-}
-func (UnimplementedSentryFlowServer) testEmbeddedByValue() {}
-
-// UnsafeSentryFlowServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SentryFlowServer will
-// result in compilation errors.
-type UnsafeSentryFlowServer interface {
-	mustEmbedUnimplementedSentryFlowServer()
-}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateCronJobEventDBClient = grpc.ServerStreamingClient[CronJob]
 
-func RegisterSentryFlowServer(s grpc.ServiceRegistrar, srv SentryFlowServer) {
-	// If the following call pancis, it indicates UnimplementedSentryFlowServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *sentryFlowClient) DeleteCronJobEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CronJob], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[27], SentryFlow_DeleteCronJobEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&SentryFlow_ServiceDesc, srv)
-}
-
-func _SentryFlow_GetAPILog_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	x := &grpc.GenericClientStream[ClientInfo, CronJob]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).GetAPILog(m, &grpc.GenericServerStream[ClientInfo, APILog]{ServerStream: stream})
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_GetAPILogServer = grpc.ServerStreamingServer[APILog]
+type SentryFlow_DeleteCronJobEventDBClient = grpc.ServerStreamingClient[CronJob]
 
-func _SentryFlow_GetEnvoyMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) AddNodeEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Node], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[28], SentryFlow_AddNodeEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).GetEnvoyMetrics(m, &grpc.GenericServerStream[ClientInfo, EnvoyMetrics]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Node]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_GetEnvoyMetricsServer = grpc.ServerStreamingServer[EnvoyMetrics]
+type SentryFlow_AddNodeEventDBClient = grpc.ServerStreamingClient[Node]
 
-func _SentryFlow_AddDeployEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) UpdateNodeEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Node], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[29], SentryFlow_UpdateNodeEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).AddDeployEventDB(m, &grpc.GenericServerStream[ClientInfo, Deploy]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Node]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_AddDeployEventDBServer = grpc.ServerStreamingServer[Deploy]
+type SentryFlow_UpdateNodeEventDBClient = grpc.ServerStreamingClient[Node]
 
-func _SentryFlow_UpdateDeployEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) DeleteNodeEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Node], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[30], SentryFlow_DeleteNodeEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).UpdateDeployEventDB(m, &grpc.GenericServerStream[ClientInfo, Deploy]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Node]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_UpdateDeployEventDBServer = grpc.ServerStreamingServer[Deploy]
+type SentryFlow_DeleteNodeEventDBClient = grpc.ServerStreamingClient[Node]
 
-func _SentryFlow_DeleteDeployEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) AddNamespaceEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Namespace], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[31], SentryFlow_AddNamespaceEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).DeleteDeployEventDB(m, &grpc.GenericServerStream[ClientInfo, Deploy]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Namespace]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_DeleteDeployEventDBServer = grpc.ServerStreamingServer[Deploy]
+type SentryFlow_AddNamespaceEventDBClient = grpc.ServerStreamingClient[Namespace]
 
-func _SentryFlow_AddPodEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) UpdateNamespaceEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Namespace], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[32], SentryFlow_UpdateNamespaceEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).AddPodEventDB(m, &grpc.GenericServerStream[ClientInfo, Pod]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Namespace]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_AddPodEventDBServer = grpc.ServerStreamingServer[Pod]
+type SentryFlow_UpdateNamespaceEventDBClient = grpc.ServerStreamingClient[Namespace]
 
-func _SentryFlow_UpdatePodEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) DeleteNamespaceEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Namespace], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[33], SentryFlow_DeleteNamespaceEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).UpdatePodEventDB(m, &grpc.GenericServerStream[ClientInfo, Pod]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Namespace]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_UpdatePodEventDBServer = grpc.ServerStreamingServer[Pod]
+type SentryFlow_DeleteNamespaceEventDBClient = grpc.ServerStreamingClient[Namespace]
 
-func _SentryFlow_DeletePodEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) AddIngressEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ingress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[34], SentryFlow_AddIngressEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).DeletePodEventDB(m, &grpc.GenericServerStream[ClientInfo, Pod]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Ingress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_DeletePodEventDBServer = grpc.ServerStreamingServer[Pod]
+type SentryFlow_AddIngressEventDBClient = grpc.ServerStreamingClient[Ingress]
 
-func _SentryFlow_AddSvcEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) UpdateIngressEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ingress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[35], SentryFlow_UpdateIngressEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).AddSvcEventDB(m, &grpc.GenericServerStream[ClientInfo, Service]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Ingress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_AddSvcEventDBServer = grpc.ServerStreamingServer[Service]
+type SentryFlow_UpdateIngressEventDBClient = grpc.ServerStreamingClient[Ingress]
 
-func _SentryFlow_UpdateSvcEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *sentryFlowClient) DeleteIngressEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ingress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[36], SentryFlow_DeleteIngressEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SentryFlowServer).UpdateSvcEventDB(m, &grpc.GenericServerStream[ClientInfo, Service]{ServerStream: stream})
+	x := &grpc.GenericClientStream[ClientInfo, Ingress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_UpdateSvcEventDBServer = grpc.ServerStreamingServer[Service]
+type SentryFlow_DeleteIngressEventDBClient = grpc.ServerStreamingClient[Ingress]
 
-func _SentryFlow_DeleteSvcEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ClientInfo)
+func (c *sentryFlowClient) AddServiceEndpointsEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServiceEndpoints], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[37], SentryFlow_AddServiceEndpointsEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClientInfo, ServiceEndpoints]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddServiceEndpointsEventDBClient = grpc.ServerStreamingClient[ServiceEndpoints]
+
+func (c *sentryFlowClient) UpdateServiceEndpointsEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServiceEndpoints], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[38], SentryFlow_UpdateServiceEndpointsEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClientInfo, ServiceEndpoints]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateServiceEndpointsEventDBClient = grpc.ServerStreamingClient[ServiceEndpoints]
+
+func (c *sentryFlowClient) DeleteServiceEndpointsEventDB(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServiceEndpoints], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[39], SentryFlow_DeleteServiceEndpointsEventDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClientInfo, ServiceEndpoints]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteServiceEndpointsEventDBClient = grpc.ServerStreamingClient[ServiceEndpoints]
+
+func (c *sentryFlowClient) GiveAPILog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[APILog, Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[40], SentryFlow_GiveAPILog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[APILog, Response]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveAPILogClient = grpc.ClientStreamingClient[APILog, Response]
+
+func (c *sentryFlowClient) GiveEnvoyMetrics(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[EnvoyMetrics, Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[41], SentryFlow_GiveEnvoyMetrics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EnvoyMetrics, Response]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveEnvoyMetricsClient = grpc.ClientStreamingClient[EnvoyMetrics, Response]
+
+func (c *sentryFlowClient) GiveConnectionLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ConnectionLog, Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[42], SentryFlow_GiveConnectionLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConnectionLog, Response]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveConnectionLogClient = grpc.ClientStreamingClient[ConnectionLog, Response]
+
+func (c *sentryFlowClient) GiveClusterNotice(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ClusterNotice, Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[43], SentryFlow_GiveClusterNotice_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClusterNotice, Response]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveClusterNoticeClient = grpc.ClientStreamingClient[ClusterNotice, Response]
+
+func (c *sentryFlowClient) GiveAuditLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AuditLog, Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[44], SentryFlow_GiveAuditLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AuditLog, Response]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveAuditLogClient = grpc.ClientStreamingClient[AuditLog, Response]
+
+func (c *sentryFlowClient) RegisterAgent(ctx context.Context, in *AgentInfo, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_RegisterAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) Heartbeat(ctx context.Context, in *AgentHeartbeat, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddDeployEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateDeployEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteDeployEvent(ctx context.Context, in *Deploy, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteDeployEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddPodEvent(ctx context.Context, in *Pod, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddPodEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdatePodEvent(ctx context.Context, in *Pod, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdatePodEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeletePodEvent(ctx context.Context, in *Pod, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeletePodEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddSvcEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateSvcEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteSvcEvent(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteSvcEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddStatefulSetEvent(ctx context.Context, in *StatefulSet, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddStatefulSetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateStatefulSetEvent(ctx context.Context, in *StatefulSet, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateStatefulSetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteStatefulSetEvent(ctx context.Context, in *StatefulSet, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteStatefulSetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddDaemonSetEvent(ctx context.Context, in *DaemonSet, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddDaemonSetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateDaemonSetEvent(ctx context.Context, in *DaemonSet, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateDaemonSetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteDaemonSetEvent(ctx context.Context, in *DaemonSet, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteDaemonSetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddJobEvent(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddJobEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateJobEvent(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateJobEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteJobEvent(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteJobEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddCronJobEvent(ctx context.Context, in *CronJob, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddCronJobEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateCronJobEvent(ctx context.Context, in *CronJob, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateCronJobEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteCronJobEvent(ctx context.Context, in *CronJob, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteCronJobEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddNodeEvent(ctx context.Context, in *Node, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddNodeEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateNodeEvent(ctx context.Context, in *Node, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateNodeEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteNodeEvent(ctx context.Context, in *Node, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteNodeEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddNamespaceEvent(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddNamespaceEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateNamespaceEvent(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateNamespaceEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteNamespaceEvent(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteNamespaceEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddIngressEvent(ctx context.Context, in *Ingress, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddIngressEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateIngressEvent(ctx context.Context, in *Ingress, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateIngressEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteIngressEvent(ctx context.Context, in *Ingress, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteIngressEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) AddServiceEndpointsEvent(ctx context.Context, in *ServiceEndpoints, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_AddServiceEndpointsEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) UpdateServiceEndpointsEvent(ctx context.Context, in *ServiceEndpoints, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_UpdateServiceEndpointsEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) DeleteServiceEndpointsEvent(ctx context.Context, in *ServiceEndpoints, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, SentryFlow_DeleteServiceEndpointsEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentryFlowClient) SyncClusterSnapshot(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ClusterSnapshot, Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SentryFlow_ServiceDesc.Streams[45], SentryFlow_SyncClusterSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClusterSnapshot, Response]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_SyncClusterSnapshotClient = grpc.ClientStreamingClient[ClusterSnapshot, Response]
+
+// SentryFlowServer is the server API for SentryFlow service.
+// All implementations should embed UnimplementedSentryFlowServer
+// for forward compatibility.
+type SentryFlowServer interface {
+	// operator -> client
+	GetAPILog(*ClientInfo, grpc.ServerStreamingServer[APILog]) error
+	GetEnvoyMetrics(*ClientInfo, grpc.ServerStreamingServer[EnvoyMetrics]) error
+	GetConnectionLog(*ClientInfo, grpc.ServerStreamingServer[ConnectionLog]) error
+	GetClusterNotice(*ClientInfo, grpc.ServerStreamingServer[ClusterNotice]) error
+	GetAuditLog(*ClientInfo, grpc.ServerStreamingServer[AuditLog]) error
+	ListAgents(context.Context, *ClientInfo) (*AgentList, error)
+	GetCallTree(*ClientInfo, grpc.ServerStreamingServer[CallTree]) error
+	QueryAPILogs(context.Context, *APILogQuery) (*APILogPage, error)
+	GetServiceGraph(context.Context, *ServiceGraphQuery) (*ServiceGraph, error)
+	WatchServiceGraph(*ServiceGraphQuery, grpc.ServerStreamingServer[ServiceGraphChange]) error
+	AddDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error
+	UpdateDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error
+	DeleteDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error
+	AddPodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error
+	UpdatePodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error
+	DeletePodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error
+	AddSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error
+	UpdateSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error
+	DeleteSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error
+	AddStatefulSetEventDB(*ClientInfo, grpc.ServerStreamingServer[StatefulSet]) error
+	UpdateStatefulSetEventDB(*ClientInfo, grpc.ServerStreamingServer[StatefulSet]) error
+	DeleteStatefulSetEventDB(*ClientInfo, grpc.ServerStreamingServer[StatefulSet]) error
+	AddDaemonSetEventDB(*ClientInfo, grpc.ServerStreamingServer[DaemonSet]) error
+	UpdateDaemonSetEventDB(*ClientInfo, grpc.ServerStreamingServer[DaemonSet]) error
+	DeleteDaemonSetEventDB(*ClientInfo, grpc.ServerStreamingServer[DaemonSet]) error
+	AddJobEventDB(*ClientInfo, grpc.ServerStreamingServer[Job]) error
+	UpdateJobEventDB(*ClientInfo, grpc.ServerStreamingServer[Job]) error
+	DeleteJobEventDB(*ClientInfo, grpc.ServerStreamingServer[Job]) error
+	AddCronJobEventDB(*ClientInfo, grpc.ServerStreamingServer[CronJob]) error
+	UpdateCronJobEventDB(*ClientInfo, grpc.ServerStreamingServer[CronJob]) error
+	DeleteCronJobEventDB(*ClientInfo, grpc.ServerStreamingServer[CronJob]) error
+	AddNodeEventDB(*ClientInfo, grpc.ServerStreamingServer[Node]) error
+	UpdateNodeEventDB(*ClientInfo, grpc.ServerStreamingServer[Node]) error
+	DeleteNodeEventDB(*ClientInfo, grpc.ServerStreamingServer[Node]) error
+	AddNamespaceEventDB(*ClientInfo, grpc.ServerStreamingServer[Namespace]) error
+	UpdateNamespaceEventDB(*ClientInfo, grpc.ServerStreamingServer[Namespace]) error
+	DeleteNamespaceEventDB(*ClientInfo, grpc.ServerStreamingServer[Namespace]) error
+	AddIngressEventDB(*ClientInfo, grpc.ServerStreamingServer[Ingress]) error
+	UpdateIngressEventDB(*ClientInfo, grpc.ServerStreamingServer[Ingress]) error
+	DeleteIngressEventDB(*ClientInfo, grpc.ServerStreamingServer[Ingress]) error
+	AddServiceEndpointsEventDB(*ClientInfo, grpc.ServerStreamingServer[ServiceEndpoints]) error
+	UpdateServiceEndpointsEventDB(*ClientInfo, grpc.ServerStreamingServer[ServiceEndpoints]) error
+	DeleteServiceEndpointsEventDB(*ClientInfo, grpc.ServerStreamingServer[ServiceEndpoints]) error
+	// agent -> operator
+	GiveAPILog(grpc.ClientStreamingServer[APILog, Response]) error
+	GiveEnvoyMetrics(grpc.ClientStreamingServer[EnvoyMetrics, Response]) error
+	GiveConnectionLog(grpc.ClientStreamingServer[ConnectionLog, Response]) error
+	GiveClusterNotice(grpc.ClientStreamingServer[ClusterNotice, Response]) error
+	GiveAuditLog(grpc.ClientStreamingServer[AuditLog, Response]) error
+	RegisterAgent(context.Context, *AgentInfo) (*Response, error)
+	Heartbeat(context.Context, *AgentHeartbeat) (*Response, error)
+	AddDeployEvent(context.Context, *Deploy) (*Response, error)
+	UpdateDeployEvent(context.Context, *Deploy) (*Response, error)
+	DeleteDeployEvent(context.Context, *Deploy) (*Response, error)
+	AddPodEvent(context.Context, *Pod) (*Response, error)
+	UpdatePodEvent(context.Context, *Pod) (*Response, error)
+	DeletePodEvent(context.Context, *Pod) (*Response, error)
+	AddSvcEvent(context.Context, *Service) (*Response, error)
+	UpdateSvcEvent(context.Context, *Service) (*Response, error)
+	DeleteSvcEvent(context.Context, *Service) (*Response, error)
+	AddStatefulSetEvent(context.Context, *StatefulSet) (*Response, error)
+	UpdateStatefulSetEvent(context.Context, *StatefulSet) (*Response, error)
+	DeleteStatefulSetEvent(context.Context, *StatefulSet) (*Response, error)
+	AddDaemonSetEvent(context.Context, *DaemonSet) (*Response, error)
+	UpdateDaemonSetEvent(context.Context, *DaemonSet) (*Response, error)
+	DeleteDaemonSetEvent(context.Context, *DaemonSet) (*Response, error)
+	AddJobEvent(context.Context, *Job) (*Response, error)
+	UpdateJobEvent(context.Context, *Job) (*Response, error)
+	DeleteJobEvent(context.Context, *Job) (*Response, error)
+	AddCronJobEvent(context.Context, *CronJob) (*Response, error)
+	UpdateCronJobEvent(context.Context, *CronJob) (*Response, error)
+	DeleteCronJobEvent(context.Context, *CronJob) (*Response, error)
+	AddNodeEvent(context.Context, *Node) (*Response, error)
+	UpdateNodeEvent(context.Context, *Node) (*Response, error)
+	DeleteNodeEvent(context.Context, *Node) (*Response, error)
+	AddNamespaceEvent(context.Context, *Namespace) (*Response, error)
+	UpdateNamespaceEvent(context.Context, *Namespace) (*Response, error)
+	DeleteNamespaceEvent(context.Context, *Namespace) (*Response, error)
+	AddIngressEvent(context.Context, *Ingress) (*Response, error)
+	UpdateIngressEvent(context.Context, *Ingress) (*Response, error)
+	DeleteIngressEvent(context.Context, *Ingress) (*Response, error)
+	AddServiceEndpointsEvent(context.Context, *ServiceEndpoints) (*Response, error)
+	UpdateServiceEndpointsEvent(context.Context, *ServiceEndpoints) (*Response, error)
+	DeleteServiceEndpointsEvent(context.Context, *ServiceEndpoints) (*Response, error)
+	SyncClusterSnapshot(grpc.ClientStreamingServer[ClusterSnapshot, Response]) error
+}
+
+// UnimplementedSentryFlowServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSentryFlowServer struct{}
+
+func (UnimplementedSentryFlowServer) GetAPILog(*ClientInfo, grpc.ServerStreamingServer[APILog]) error {
+	return status.Errorf(codes.Unimplemented, "method GetAPILog not implemented")
+}
+func (UnimplementedSentryFlowServer) GetEnvoyMetrics(*ClientInfo, grpc.ServerStreamingServer[EnvoyMetrics]) error {
+	return status.Errorf(codes.Unimplemented, "method GetEnvoyMetrics not implemented")
+}
+func (UnimplementedSentryFlowServer) GetConnectionLog(*ClientInfo, grpc.ServerStreamingServer[ConnectionLog]) error {
+	return status.Errorf(codes.Unimplemented, "method GetConnectionLog not implemented")
+}
+func (UnimplementedSentryFlowServer) GetClusterNotice(*ClientInfo, grpc.ServerStreamingServer[ClusterNotice]) error {
+	return status.Errorf(codes.Unimplemented, "method GetClusterNotice not implemented")
+}
+func (UnimplementedSentryFlowServer) GetAuditLog(*ClientInfo, grpc.ServerStreamingServer[AuditLog]) error {
+	return status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedSentryFlowServer) ListAgents(context.Context, *ClientInfo) (*AgentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgents not implemented")
+}
+func (UnimplementedSentryFlowServer) GetCallTree(*ClientInfo, grpc.ServerStreamingServer[CallTree]) error {
+	return status.Errorf(codes.Unimplemented, "method GetCallTree not implemented")
+}
+func (UnimplementedSentryFlowServer) QueryAPILogs(context.Context, *APILogQuery) (*APILogPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAPILogs not implemented")
+}
+func (UnimplementedSentryFlowServer) GetServiceGraph(context.Context, *ServiceGraphQuery) (*ServiceGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceGraph not implemented")
+}
+func (UnimplementedSentryFlowServer) WatchServiceGraph(*ServiceGraphQuery, grpc.ServerStreamingServer[ServiceGraphChange]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchServiceGraph not implemented")
+}
+func (UnimplementedSentryFlowServer) AddDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error {
+	return status.Errorf(codes.Unimplemented, "method AddDeployEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateDeployEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteDeployEventDB(*ClientInfo, grpc.ServerStreamingServer[Deploy]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteDeployEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddPodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error {
+	return status.Errorf(codes.Unimplemented, "method AddPodEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdatePodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdatePodEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeletePodEventDB(*ClientInfo, grpc.ServerStreamingServer[Pod]) error {
+	return status.Errorf(codes.Unimplemented, "method DeletePodEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error {
+	return status.Errorf(codes.Unimplemented, "method AddSvcEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateSvcEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteSvcEventDB(*ClientInfo, grpc.ServerStreamingServer[Service]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteSvcEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddStatefulSetEventDB(*ClientInfo, grpc.ServerStreamingServer[StatefulSet]) error {
+	return status.Errorf(codes.Unimplemented, "method AddStatefulSetEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateStatefulSetEventDB(*ClientInfo, grpc.ServerStreamingServer[StatefulSet]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateStatefulSetEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteStatefulSetEventDB(*ClientInfo, grpc.ServerStreamingServer[StatefulSet]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteStatefulSetEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddDaemonSetEventDB(*ClientInfo, grpc.ServerStreamingServer[DaemonSet]) error {
+	return status.Errorf(codes.Unimplemented, "method AddDaemonSetEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateDaemonSetEventDB(*ClientInfo, grpc.ServerStreamingServer[DaemonSet]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateDaemonSetEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteDaemonSetEventDB(*ClientInfo, grpc.ServerStreamingServer[DaemonSet]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteDaemonSetEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddJobEventDB(*ClientInfo, grpc.ServerStreamingServer[Job]) error {
+	return status.Errorf(codes.Unimplemented, "method AddJobEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateJobEventDB(*ClientInfo, grpc.ServerStreamingServer[Job]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateJobEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteJobEventDB(*ClientInfo, grpc.ServerStreamingServer[Job]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteJobEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddCronJobEventDB(*ClientInfo, grpc.ServerStreamingServer[CronJob]) error {
+	return status.Errorf(codes.Unimplemented, "method AddCronJobEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateCronJobEventDB(*ClientInfo, grpc.ServerStreamingServer[CronJob]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateCronJobEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteCronJobEventDB(*ClientInfo, grpc.ServerStreamingServer[CronJob]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteCronJobEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddNodeEventDB(*ClientInfo, grpc.ServerStreamingServer[Node]) error {
+	return status.Errorf(codes.Unimplemented, "method AddNodeEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateNodeEventDB(*ClientInfo, grpc.ServerStreamingServer[Node]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateNodeEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteNodeEventDB(*ClientInfo, grpc.ServerStreamingServer[Node]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteNodeEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddNamespaceEventDB(*ClientInfo, grpc.ServerStreamingServer[Namespace]) error {
+	return status.Errorf(codes.Unimplemented, "method AddNamespaceEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateNamespaceEventDB(*ClientInfo, grpc.ServerStreamingServer[Namespace]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateNamespaceEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteNamespaceEventDB(*ClientInfo, grpc.ServerStreamingServer[Namespace]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteNamespaceEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddIngressEventDB(*ClientInfo, grpc.ServerStreamingServer[Ingress]) error {
+	return status.Errorf(codes.Unimplemented, "method AddIngressEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateIngressEventDB(*ClientInfo, grpc.ServerStreamingServer[Ingress]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateIngressEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteIngressEventDB(*ClientInfo, grpc.ServerStreamingServer[Ingress]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteIngressEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) AddServiceEndpointsEventDB(*ClientInfo, grpc.ServerStreamingServer[ServiceEndpoints]) error {
+	return status.Errorf(codes.Unimplemented, "method AddServiceEndpointsEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateServiceEndpointsEventDB(*ClientInfo, grpc.ServerStreamingServer[ServiceEndpoints]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateServiceEndpointsEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteServiceEndpointsEventDB(*ClientInfo, grpc.ServerStreamingServer[ServiceEndpoints]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteServiceEndpointsEventDB not implemented")
+}
+func (UnimplementedSentryFlowServer) GiveAPILog(grpc.ClientStreamingServer[APILog, Response]) error {
+	return status.Errorf(codes.Unimplemented, "method GiveAPILog not implemented")
+}
+func (UnimplementedSentryFlowServer) GiveEnvoyMetrics(grpc.ClientStreamingServer[EnvoyMetrics, Response]) error {
+	return status.Errorf(codes.Unimplemented, "method GiveEnvoyMetrics not implemented")
+}
+func (UnimplementedSentryFlowServer) GiveConnectionLog(grpc.ClientStreamingServer[ConnectionLog, Response]) error {
+	return status.Errorf(codes.Unimplemented, "method GiveConnectionLog not implemented")
+}
+func (UnimplementedSentryFlowServer) GiveClusterNotice(grpc.ClientStreamingServer[ClusterNotice, Response]) error {
+	return status.Errorf(codes.Unimplemented, "method GiveClusterNotice not implemented")
+}
+func (UnimplementedSentryFlowServer) GiveAuditLog(grpc.ClientStreamingServer[AuditLog, Response]) error {
+	return status.Errorf(codes.Unimplemented, "method GiveAuditLog not implemented")
+}
+func (UnimplementedSentryFlowServer) RegisterAgent(context.Context, *AgentInfo) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAgent not implemented")
+}
+func (UnimplementedSentryFlowServer) Heartbeat(context.Context, *AgentHeartbeat) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedSentryFlowServer) AddDeployEvent(context.Context, *Deploy) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDeployEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateDeployEvent(context.Context, *Deploy) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeployEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteDeployEvent(context.Context, *Deploy) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeployEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddPodEvent(context.Context, *Pod) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPodEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdatePodEvent(context.Context, *Pod) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePodEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeletePodEvent(context.Context, *Pod) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePodEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddSvcEvent(context.Context, *Service) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSvcEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateSvcEvent(context.Context, *Service) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSvcEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteSvcEvent(context.Context, *Service) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSvcEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddStatefulSetEvent(context.Context, *StatefulSet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStatefulSetEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateStatefulSetEvent(context.Context, *StatefulSet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatefulSetEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteStatefulSetEvent(context.Context, *StatefulSet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStatefulSetEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddDaemonSetEvent(context.Context, *DaemonSet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDaemonSetEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateDaemonSetEvent(context.Context, *DaemonSet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDaemonSetEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteDaemonSetEvent(context.Context, *DaemonSet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDaemonSetEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddJobEvent(context.Context, *Job) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddJobEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateJobEvent(context.Context, *Job) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteJobEvent(context.Context, *Job) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddCronJobEvent(context.Context, *CronJob) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCronJobEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateCronJobEvent(context.Context, *CronJob) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCronJobEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteCronJobEvent(context.Context, *CronJob) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronJobEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddNodeEvent(context.Context, *Node) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNodeEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateNodeEvent(context.Context, *Node) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteNodeEvent(context.Context, *Node) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNodeEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddNamespaceEvent(context.Context, *Namespace) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNamespaceEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateNamespaceEvent(context.Context, *Namespace) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteNamespaceEvent(context.Context, *Namespace) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespaceEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddIngressEvent(context.Context, *Ingress) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIngressEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateIngressEvent(context.Context, *Ingress) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIngressEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteIngressEvent(context.Context, *Ingress) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIngressEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) AddServiceEndpointsEvent(context.Context, *ServiceEndpoints) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddServiceEndpointsEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) UpdateServiceEndpointsEvent(context.Context, *ServiceEndpoints) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceEndpointsEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) DeleteServiceEndpointsEvent(context.Context, *ServiceEndpoints) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceEndpointsEvent not implemented")
+}
+func (UnimplementedSentryFlowServer) SyncClusterSnapshot(grpc.ClientStreamingServer[ClusterSnapshot, Response]) error {
+	return status.Errorf(codes.Unimplemented, "method SyncClusterSnapshot not implemented")
+}
+func (UnimplementedSentryFlowServer) testEmbeddedByValue() {}
+
+// UnsafeSentryFlowServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SentryFlowServer will
+// result in compilation errors.
+type UnsafeSentryFlowServer interface {
+	mustEmbedUnimplementedSentryFlowServer()
+}
+
+func RegisterSentryFlowServer(s grpc.ServiceRegistrar, srv SentryFlowServer) {
+	// If the following call pancis, it indicates UnimplementedSentryFlowServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SentryFlow_ServiceDesc, srv)
+}
+
+func _SentryFlow_GetAPILog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).GetAPILog(m, &grpc.GenericServerStream[ClientInfo, APILog]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetAPILogServer = grpc.ServerStreamingServer[APILog]
+
+func _SentryFlow_GetEnvoyMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).GetEnvoyMetrics(m, &grpc.GenericServerStream[ClientInfo, EnvoyMetrics]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetEnvoyMetricsServer = grpc.ServerStreamingServer[EnvoyMetrics]
+
+func _SentryFlow_GetConnectionLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).GetConnectionLog(m, &grpc.GenericServerStream[ClientInfo, ConnectionLog]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetConnectionLogServer = grpc.ServerStreamingServer[ConnectionLog]
+
+func _SentryFlow_GetClusterNotice_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).GetClusterNotice(m, &grpc.GenericServerStream[ClientInfo, ClusterNotice]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetClusterNoticeServer = grpc.ServerStreamingServer[ClusterNotice]
+
+func _SentryFlow_GetAuditLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).GetAuditLog(m, &grpc.GenericServerStream[ClientInfo, AuditLog]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetAuditLogServer = grpc.ServerStreamingServer[AuditLog]
+
+func _SentryFlow_ListAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).ListAgents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_ListAgents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).ListAgents(ctx, req.(*ClientInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_GetCallTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).GetCallTree(m, &grpc.GenericServerStream[ClientInfo, CallTree]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GetCallTreeServer = grpc.ServerStreamingServer[CallTree]
+
+func _SentryFlow_QueryAPILogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APILogQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).QueryAPILogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_QueryAPILogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).QueryAPILogs(ctx, req.(*APILogQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_GetServiceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceGraphQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).GetServiceGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_GetServiceGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).GetServiceGraph(ctx, req.(*ServiceGraphQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_WatchServiceGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServiceGraphQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).WatchServiceGraph(m, &grpc.GenericServerStream[ServiceGraphQuery, ServiceGraphChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_WatchServiceGraphServer = grpc.ServerStreamingServer[ServiceGraphChange]
+
+func _SentryFlow_AddDeployEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddDeployEventDB(m, &grpc.GenericServerStream[ClientInfo, Deploy]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddDeployEventDBServer = grpc.ServerStreamingServer[Deploy]
+
+func _SentryFlow_UpdateDeployEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateDeployEventDB(m, &grpc.GenericServerStream[ClientInfo, Deploy]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateDeployEventDBServer = grpc.ServerStreamingServer[Deploy]
+
+func _SentryFlow_DeleteDeployEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteDeployEventDB(m, &grpc.GenericServerStream[ClientInfo, Deploy]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteDeployEventDBServer = grpc.ServerStreamingServer[Deploy]
+
+func _SentryFlow_AddPodEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddPodEventDB(m, &grpc.GenericServerStream[ClientInfo, Pod]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddPodEventDBServer = grpc.ServerStreamingServer[Pod]
+
+func _SentryFlow_UpdatePodEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdatePodEventDB(m, &grpc.GenericServerStream[ClientInfo, Pod]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdatePodEventDBServer = grpc.ServerStreamingServer[Pod]
+
+func _SentryFlow_DeletePodEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeletePodEventDB(m, &grpc.GenericServerStream[ClientInfo, Pod]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeletePodEventDBServer = grpc.ServerStreamingServer[Pod]
+
+func _SentryFlow_AddSvcEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddSvcEventDB(m, &grpc.GenericServerStream[ClientInfo, Service]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddSvcEventDBServer = grpc.ServerStreamingServer[Service]
+
+func _SentryFlow_UpdateSvcEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateSvcEventDB(m, &grpc.GenericServerStream[ClientInfo, Service]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateSvcEventDBServer = grpc.ServerStreamingServer[Service]
+
+func _SentryFlow_DeleteSvcEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteSvcEventDB(m, &grpc.GenericServerStream[ClientInfo, Service]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteSvcEventDBServer = grpc.ServerStreamingServer[Service]
+
+func _SentryFlow_AddStatefulSetEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddStatefulSetEventDB(m, &grpc.GenericServerStream[ClientInfo, StatefulSet]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddStatefulSetEventDBServer = grpc.ServerStreamingServer[StatefulSet]
+
+func _SentryFlow_UpdateStatefulSetEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateStatefulSetEventDB(m, &grpc.GenericServerStream[ClientInfo, StatefulSet]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateStatefulSetEventDBServer = grpc.ServerStreamingServer[StatefulSet]
+
+func _SentryFlow_DeleteStatefulSetEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteStatefulSetEventDB(m, &grpc.GenericServerStream[ClientInfo, StatefulSet]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteStatefulSetEventDBServer = grpc.ServerStreamingServer[StatefulSet]
+
+func _SentryFlow_AddDaemonSetEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddDaemonSetEventDB(m, &grpc.GenericServerStream[ClientInfo, DaemonSet]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddDaemonSetEventDBServer = grpc.ServerStreamingServer[DaemonSet]
+
+func _SentryFlow_UpdateDaemonSetEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateDaemonSetEventDB(m, &grpc.GenericServerStream[ClientInfo, DaemonSet]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateDaemonSetEventDBServer = grpc.ServerStreamingServer[DaemonSet]
+
+func _SentryFlow_DeleteDaemonSetEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteDaemonSetEventDB(m, &grpc.GenericServerStream[ClientInfo, DaemonSet]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteDaemonSetEventDBServer = grpc.ServerStreamingServer[DaemonSet]
+
+func _SentryFlow_AddJobEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddJobEventDB(m, &grpc.GenericServerStream[ClientInfo, Job]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddJobEventDBServer = grpc.ServerStreamingServer[Job]
+
+func _SentryFlow_UpdateJobEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateJobEventDB(m, &grpc.GenericServerStream[ClientInfo, Job]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateJobEventDBServer = grpc.ServerStreamingServer[Job]
+
+func _SentryFlow_DeleteJobEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteJobEventDB(m, &grpc.GenericServerStream[ClientInfo, Job]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteJobEventDBServer = grpc.ServerStreamingServer[Job]
+
+func _SentryFlow_AddCronJobEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddCronJobEventDB(m, &grpc.GenericServerStream[ClientInfo, CronJob]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddCronJobEventDBServer = grpc.ServerStreamingServer[CronJob]
+
+func _SentryFlow_UpdateCronJobEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateCronJobEventDB(m, &grpc.GenericServerStream[ClientInfo, CronJob]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateCronJobEventDBServer = grpc.ServerStreamingServer[CronJob]
+
+func _SentryFlow_DeleteCronJobEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteCronJobEventDB(m, &grpc.GenericServerStream[ClientInfo, CronJob]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteCronJobEventDBServer = grpc.ServerStreamingServer[CronJob]
+
+func _SentryFlow_AddNodeEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddNodeEventDB(m, &grpc.GenericServerStream[ClientInfo, Node]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddNodeEventDBServer = grpc.ServerStreamingServer[Node]
+
+func _SentryFlow_UpdateNodeEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateNodeEventDB(m, &grpc.GenericServerStream[ClientInfo, Node]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateNodeEventDBServer = grpc.ServerStreamingServer[Node]
+
+func _SentryFlow_DeleteNodeEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteNodeEventDB(m, &grpc.GenericServerStream[ClientInfo, Node]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteNodeEventDBServer = grpc.ServerStreamingServer[Node]
+
+func _SentryFlow_AddNamespaceEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddNamespaceEventDB(m, &grpc.GenericServerStream[ClientInfo, Namespace]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddNamespaceEventDBServer = grpc.ServerStreamingServer[Namespace]
+
+func _SentryFlow_UpdateNamespaceEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateNamespaceEventDB(m, &grpc.GenericServerStream[ClientInfo, Namespace]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateNamespaceEventDBServer = grpc.ServerStreamingServer[Namespace]
+
+func _SentryFlow_DeleteNamespaceEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteNamespaceEventDB(m, &grpc.GenericServerStream[ClientInfo, Namespace]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteNamespaceEventDBServer = grpc.ServerStreamingServer[Namespace]
+
+func _SentryFlow_AddIngressEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddIngressEventDB(m, &grpc.GenericServerStream[ClientInfo, Ingress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddIngressEventDBServer = grpc.ServerStreamingServer[Ingress]
+
+func _SentryFlow_UpdateIngressEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateIngressEventDB(m, &grpc.GenericServerStream[ClientInfo, Ingress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateIngressEventDBServer = grpc.ServerStreamingServer[Ingress]
+
+func _SentryFlow_DeleteIngressEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).DeleteIngressEventDB(m, &grpc.GenericServerStream[ClientInfo, Ingress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteIngressEventDBServer = grpc.ServerStreamingServer[Ingress]
+
+func _SentryFlow_AddServiceEndpointsEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).AddServiceEndpointsEventDB(m, &grpc.GenericServerStream[ClientInfo, ServiceEndpoints]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_AddServiceEndpointsEventDBServer = grpc.ServerStreamingServer[ServiceEndpoints]
+
+func _SentryFlow_UpdateServiceEndpointsEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SentryFlowServer).UpdateServiceEndpointsEventDB(m, &grpc.GenericServerStream[ClientInfo, ServiceEndpoints]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_UpdateServiceEndpointsEventDBServer = grpc.ServerStreamingServer[ServiceEndpoints]
+
+func _SentryFlow_DeleteServiceEndpointsEventDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientInfo)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(SentryFlowServer).DeleteSvcEventDB(m, &grpc.GenericServerStream[ClientInfo, Service]{ServerStream: stream})
+	return srv.(SentryFlowServer).DeleteServiceEndpointsEventDB(m, &grpc.GenericServerStream[ClientInfo, ServiceEndpoints]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_DeleteServiceEndpointsEventDBServer = grpc.ServerStreamingServer[ServiceEndpoints]
+
+func _SentryFlow_GiveAPILog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SentryFlowServer).GiveAPILog(&grpc.GenericServerStream[APILog, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveAPILogServer = grpc.ClientStreamingServer[APILog, Response]
+
+func _SentryFlow_GiveEnvoyMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SentryFlowServer).GiveEnvoyMetrics(&grpc.GenericServerStream[EnvoyMetrics, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveEnvoyMetricsServer = grpc.ClientStreamingServer[EnvoyMetrics, Response]
+
+func _SentryFlow_GiveConnectionLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SentryFlowServer).GiveConnectionLog(&grpc.GenericServerStream[ConnectionLog, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveConnectionLogServer = grpc.ClientStreamingServer[ConnectionLog, Response]
+
+func _SentryFlow_GiveClusterNotice_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SentryFlowServer).GiveClusterNotice(&grpc.GenericServerStream[ClusterNotice, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveClusterNoticeServer = grpc.ClientStreamingServer[ClusterNotice, Response]
+
+func _SentryFlow_GiveAuditLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SentryFlowServer).GiveAuditLog(&grpc.GenericServerStream[AuditLog, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_GiveAuditLogServer = grpc.ClientStreamingServer[AuditLog, Response]
+
+func _SentryFlow_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).RegisterAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_RegisterAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).RegisterAgent(ctx, req.(*AgentInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).Heartbeat(ctx, req.(*AgentHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_AddDeployEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Deploy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).AddDeployEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_AddDeployEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).AddDeployEvent(ctx, req.(*Deploy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_UpdateDeployEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Deploy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).UpdateDeployEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_UpdateDeployEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).UpdateDeployEvent(ctx, req.(*Deploy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_DeleteDeployEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Deploy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).DeleteDeployEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_DeleteDeployEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).DeleteDeployEvent(ctx, req.(*Deploy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_AddPodEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).AddPodEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_AddPodEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).AddPodEvent(ctx, req.(*Pod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_UpdatePodEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).UpdatePodEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_UpdatePodEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).UpdatePodEvent(ctx, req.(*Pod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_DeletePodEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).DeletePodEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_DeletePodEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).DeletePodEvent(ctx, req.(*Pod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_AddSvcEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Service)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).AddSvcEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_AddSvcEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).AddSvcEvent(ctx, req.(*Service))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_UpdateSvcEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Service)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).UpdateSvcEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_UpdateSvcEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).UpdateSvcEvent(ctx, req.(*Service))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_DeleteSvcEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Service)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).DeleteSvcEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_DeleteSvcEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).DeleteSvcEvent(ctx, req.(*Service))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_AddStatefulSetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatefulSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).AddStatefulSetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_AddStatefulSetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).AddStatefulSetEvent(ctx, req.(*StatefulSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_UpdateStatefulSetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatefulSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).UpdateStatefulSetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_UpdateStatefulSetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).UpdateStatefulSetEvent(ctx, req.(*StatefulSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_DeleteStatefulSetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatefulSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).DeleteStatefulSetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_DeleteStatefulSetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).DeleteStatefulSetEvent(ctx, req.(*StatefulSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_AddDaemonSetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaemonSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).AddDaemonSetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_AddDaemonSetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).AddDaemonSetEvent(ctx, req.(*DaemonSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_UpdateDaemonSetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaemonSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).UpdateDaemonSetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_UpdateDaemonSetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).UpdateDaemonSetEvent(ctx, req.(*DaemonSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_DeleteDaemonSetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaemonSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).DeleteDaemonSetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_DeleteDaemonSetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).DeleteDaemonSetEvent(ctx, req.(*DaemonSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_AddJobEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Job)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).AddJobEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_AddJobEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).AddJobEvent(ctx, req.(*Job))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_UpdateJobEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Job)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).UpdateJobEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_UpdateJobEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).UpdateJobEvent(ctx, req.(*Job))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_DeleteJobEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Job)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).DeleteJobEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_DeleteJobEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).DeleteJobEvent(ctx, req.(*Job))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_AddCronJobEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).AddCronJobEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_AddCronJobEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).AddCronJobEvent(ctx, req.(*CronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_UpdateCronJobEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).UpdateCronJobEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_UpdateCronJobEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).UpdateCronJobEvent(ctx, req.(*CronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentryFlow_DeleteCronJobEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).DeleteCronJobEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_DeleteCronJobEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).DeleteCronJobEvent(ctx, req.(*CronJob))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_DeleteSvcEventDBServer = grpc.ServerStreamingServer[Service]
-
-func _SentryFlow_GiveAPILog_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SentryFlowServer).GiveAPILog(&grpc.GenericServerStream[APILog, Response]{ServerStream: stream})
+func _SentryFlow_AddNodeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).AddNodeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_AddNodeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).AddNodeEvent(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_GiveAPILogServer = grpc.ClientStreamingServer[APILog, Response]
-
-func _SentryFlow_GiveEnvoyMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SentryFlowServer).GiveEnvoyMetrics(&grpc.GenericServerStream[EnvoyMetrics, Response]{ServerStream: stream})
+func _SentryFlow_UpdateNodeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).UpdateNodeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_UpdateNodeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).UpdateNodeEvent(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SentryFlow_GiveEnvoyMetricsServer = grpc.ClientStreamingServer[EnvoyMetrics, Response]
+func _SentryFlow_DeleteNodeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryFlowServer).DeleteNodeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SentryFlow_DeleteNodeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryFlowServer).DeleteNodeEvent(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func _SentryFlow_AddDeployEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Deploy)
+func _SentryFlow_AddNamespaceEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Namespace)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).AddDeployEvent(ctx, in)
+		return srv.(SentryFlowServer).AddNamespaceEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_AddDeployEvent_FullMethodName,
+		FullMethod: SentryFlow_AddNamespaceEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).AddDeployEvent(ctx, req.(*Deploy))
+		return srv.(SentryFlowServer).AddNamespaceEvent(ctx, req.(*Namespace))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SentryFlow_UpdateDeployEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Deploy)
+func _SentryFlow_UpdateNamespaceEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Namespace)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).UpdateDeployEvent(ctx, in)
+		return srv.(SentryFlowServer).UpdateNamespaceEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_UpdateDeployEvent_FullMethodName,
+		FullMethod: SentryFlow_UpdateNamespaceEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).UpdateDeployEvent(ctx, req.(*Deploy))
+		return srv.(SentryFlowServer).UpdateNamespaceEvent(ctx, req.(*Namespace))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SentryFlow_DeleteDeployEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Deploy)
+func _SentryFlow_DeleteNamespaceEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Namespace)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).DeleteDeployEvent(ctx, in)
+		return srv.(SentryFlowServer).DeleteNamespaceEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_DeleteDeployEvent_FullMethodName,
+		FullMethod: SentryFlow_DeleteNamespaceEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).DeleteDeployEvent(ctx, req.(*Deploy))
+		return srv.(SentryFlowServer).DeleteNamespaceEvent(ctx, req.(*Namespace))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SentryFlow_AddPodEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Pod)
+func _SentryFlow_AddIngressEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Ingress)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).AddPodEvent(ctx, in)
+		return srv.(SentryFlowServer).AddIngressEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_AddPodEvent_FullMethodName,
+		FullMethod: SentryFlow_AddIngressEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).AddPodEvent(ctx, req.(*Pod))
+		return srv.(SentryFlowServer).AddIngressEvent(ctx, req.(*Ingress))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SentryFlow_UpdatePodEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Pod)
+func _SentryFlow_UpdateIngressEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Ingress)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).UpdatePodEvent(ctx, in)
+		return srv.(SentryFlowServer).UpdateIngressEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_UpdatePodEvent_FullMethodName,
+		FullMethod: SentryFlow_UpdateIngressEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).UpdatePodEvent(ctx, req.(*Pod))
+		return srv.(SentryFlowServer).UpdateIngressEvent(ctx, req.(*Ingress))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SentryFlow_DeletePodEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Pod)
+func _SentryFlow_DeleteIngressEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Ingress)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).DeletePodEvent(ctx, in)
+		return srv.(SentryFlowServer).DeleteIngressEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_DeletePodEvent_FullMethodName,
+		FullMethod: SentryFlow_DeleteIngressEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).DeletePodEvent(ctx, req.(*Pod))
+		return srv.(SentryFlowServer).DeleteIngressEvent(ctx, req.(*Ingress))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SentryFlow_AddSvcEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Service)
+func _SentryFlow_AddServiceEndpointsEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceEndpoints)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).AddSvcEvent(ctx, in)
+		return srv.(SentryFlowServer).AddServiceEndpointsEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_AddSvcEvent_FullMethodName,
+		FullMethod: SentryFlow_AddServiceEndpointsEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).AddSvcEvent(ctx, req.(*Service))
+		return srv.(SentryFlowServer).AddServiceEndpointsEvent(ctx, req.(*ServiceEndpoints))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SentryFlow_UpdateSvcEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Service)
+func _SentryFlow_UpdateServiceEndpointsEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceEndpoints)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).UpdateSvcEvent(ctx, in)
+		return srv.(SentryFlowServer).UpdateServiceEndpointsEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_UpdateSvcEvent_FullMethodName,
+		FullMethod: SentryFlow_UpdateServiceEndpointsEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).UpdateSvcEvent(ctx, req.(*Service))
+		return srv.(SentryFlowServer).UpdateServiceEndpointsEvent(ctx, req.(*ServiceEndpoints))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SentryFlow_DeleteSvcEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Service)
+func _SentryFlow_DeleteServiceEndpointsEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceEndpoints)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SentryFlowServer).DeleteSvcEvent(ctx, in)
+		return srv.(SentryFlowServer).DeleteServiceEndpointsEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SentryFlow_DeleteSvcEvent_FullMethodName,
+		FullMethod: SentryFlow_DeleteServiceEndpointsEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SentryFlowServer).DeleteSvcEvent(ctx, req.(*Service))
+		return srv.(SentryFlowServer).DeleteServiceEndpointsEvent(ctx, req.(*ServiceEndpoints))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SentryFlow_SyncClusterSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SentryFlowServer).SyncClusterSnapshot(&grpc.GenericServerStream[ClusterSnapshot, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SentryFlow_SyncClusterSnapshotServer = grpc.ClientStreamingServer[ClusterSnapshot, Response]
+
 // SentryFlow_ServiceDesc is the grpc.ServiceDesc for SentryFlow service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -833,6 +2967,26 @@ var SentryFlow_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "protobuf.SentryFlow",
 	HandlerType: (*SentryFlowServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAgents",
+			Handler:    _SentryFlow_ListAgents_Handler,
+		},
+		{
+			MethodName: "QueryAPILogs",
+			Handler:    _SentryFlow_QueryAPILogs_Handler,
+		},
+		{
+			MethodName: "GetServiceGraph",
+			Handler:    _SentryFlow_GetServiceGraph_Handler,
+		},
+		{
+			MethodName: "RegisterAgent",
+			Handler:    _SentryFlow_RegisterAgent_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _SentryFlow_Heartbeat_Handler,
+		},
 		{
 			MethodName: "AddDeployEvent",
 			Handler:    _SentryFlow_AddDeployEvent_Handler,
@@ -869,6 +3023,102 @@ var SentryFlow_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSvcEvent",
 			Handler:    _SentryFlow_DeleteSvcEvent_Handler,
 		},
+		{
+			MethodName: "AddStatefulSetEvent",
+			Handler:    _SentryFlow_AddStatefulSetEvent_Handler,
+		},
+		{
+			MethodName: "UpdateStatefulSetEvent",
+			Handler:    _SentryFlow_UpdateStatefulSetEvent_Handler,
+		},
+		{
+			MethodName: "DeleteStatefulSetEvent",
+			Handler:    _SentryFlow_DeleteStatefulSetEvent_Handler,
+		},
+		{
+			MethodName: "AddDaemonSetEvent",
+			Handler:    _SentryFlow_AddDaemonSetEvent_Handler,
+		},
+		{
+			MethodName: "UpdateDaemonSetEvent",
+			Handler:    _SentryFlow_UpdateDaemonSetEvent_Handler,
+		},
+		{
+			MethodName: "DeleteDaemonSetEvent",
+			Handler:    _SentryFlow_DeleteDaemonSetEvent_Handler,
+		},
+		{
+			MethodName: "AddJobEvent",
+			Handler:    _SentryFlow_AddJobEvent_Handler,
+		},
+		{
+			MethodName: "UpdateJobEvent",
+			Handler:    _SentryFlow_UpdateJobEvent_Handler,
+		},
+		{
+			MethodName: "DeleteJobEvent",
+			Handler:    _SentryFlow_DeleteJobEvent_Handler,
+		},
+		{
+			MethodName: "AddCronJobEvent",
+			Handler:    _SentryFlow_AddCronJobEvent_Handler,
+		},
+		{
+			MethodName: "UpdateCronJobEvent",
+			Handler:    _SentryFlow_UpdateCronJobEvent_Handler,
+		},
+		{
+			MethodName: "DeleteCronJobEvent",
+			Handler:    _SentryFlow_DeleteCronJobEvent_Handler,
+		},
+		{
+			MethodName: "AddNodeEvent",
+			Handler:    _SentryFlow_AddNodeEvent_Handler,
+		},
+		{
+			MethodName: "UpdateNodeEvent",
+			Handler:    _SentryFlow_UpdateNodeEvent_Handler,
+		},
+		{
+			MethodName: "DeleteNodeEvent",
+			Handler:    _SentryFlow_DeleteNodeEvent_Handler,
+		},
+		{
+			MethodName: "AddNamespaceEvent",
+			Handler:    _SentryFlow_AddNamespaceEvent_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceEvent",
+			Handler:    _SentryFlow_UpdateNamespaceEvent_Handler,
+		},
+		{
+			MethodName: "DeleteNamespaceEvent",
+			Handler:    _SentryFlow_DeleteNamespaceEvent_Handler,
+		},
+		{
+			MethodName: "AddIngressEvent",
+			Handler:    _SentryFlow_AddIngressEvent_Handler,
+		},
+		{
+			MethodName: "UpdateIngressEvent",
+			Handler:    _SentryFlow_UpdateIngressEvent_Handler,
+		},
+		{
+			MethodName: "DeleteIngressEvent",
+			Handler:    _SentryFlow_DeleteIngressEvent_Handler,
+		},
+		{
+			MethodName: "AddServiceEndpointsEvent",
+			Handler:    _SentryFlow_AddServiceEndpointsEvent_Handler,
+		},
+		{
+			MethodName: "UpdateServiceEndpointsEvent",
+			Handler:    _SentryFlow_UpdateServiceEndpointsEvent_Handler,
+		},
+		{
+			MethodName: "DeleteServiceEndpointsEvent",
+			Handler:    _SentryFlow_DeleteServiceEndpointsEvent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -881,6 +3131,31 @@ var SentryFlow_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SentryFlow_GetEnvoyMetrics_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetConnectionLog",
+			Handler:       _SentryFlow_GetConnectionLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetClusterNotice",
+			Handler:       _SentryFlow_GetClusterNotice_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAuditLog",
+			Handler:       _SentryFlow_GetAuditLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetCallTree",
+			Handler:       _SentryFlow_GetCallTree_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchServiceGraph",
+			Handler:       _SentryFlow_WatchServiceGraph_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "AddDeployEventDB",
 			Handler:       _SentryFlow_AddDeployEventDB_Handler,
@@ -926,6 +3201,126 @@ var SentryFlow_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SentryFlow_DeleteSvcEventDB_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AddStatefulSetEventDB",
+			Handler:       _SentryFlow_AddStatefulSetEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateStatefulSetEventDB",
+			Handler:       _SentryFlow_UpdateStatefulSetEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteStatefulSetEventDB",
+			Handler:       _SentryFlow_DeleteStatefulSetEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddDaemonSetEventDB",
+			Handler:       _SentryFlow_AddDaemonSetEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateDaemonSetEventDB",
+			Handler:       _SentryFlow_UpdateDaemonSetEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteDaemonSetEventDB",
+			Handler:       _SentryFlow_DeleteDaemonSetEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddJobEventDB",
+			Handler:       _SentryFlow_AddJobEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateJobEventDB",
+			Handler:       _SentryFlow_UpdateJobEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteJobEventDB",
+			Handler:       _SentryFlow_DeleteJobEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddCronJobEventDB",
+			Handler:       _SentryFlow_AddCronJobEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateCronJobEventDB",
+			Handler:       _SentryFlow_UpdateCronJobEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteCronJobEventDB",
+			Handler:       _SentryFlow_DeleteCronJobEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddNodeEventDB",
+			Handler:       _SentryFlow_AddNodeEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateNodeEventDB",
+			Handler:       _SentryFlow_UpdateNodeEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteNodeEventDB",
+			Handler:       _SentryFlow_DeleteNodeEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddNamespaceEventDB",
+			Handler:       _SentryFlow_AddNamespaceEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateNamespaceEventDB",
+			Handler:       _SentryFlow_UpdateNamespaceEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteNamespaceEventDB",
+			Handler:       _SentryFlow_DeleteNamespaceEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddIngressEventDB",
+			Handler:       _SentryFlow_AddIngressEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateIngressEventDB",
+			Handler:       _SentryFlow_UpdateIngressEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteIngressEventDB",
+			Handler:       _SentryFlow_DeleteIngressEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddServiceEndpointsEventDB",
+			Handler:       _SentryFlow_AddServiceEndpointsEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateServiceEndpointsEventDB",
+			Handler:       _SentryFlow_UpdateServiceEndpointsEventDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteServiceEndpointsEventDB",
+			Handler:       _SentryFlow_DeleteServiceEndpointsEventDB_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GiveAPILog",
 			Handler:       _SentryFlow_GiveAPILog_Handler,
@@ -936,6 +3331,26 @@ var SentryFlow_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SentryFlow_GiveEnvoyMetrics_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "GiveConnectionLog",
+			Handler:       _SentryFlow_GiveConnectionLog_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GiveClusterNotice",
+			Handler:       _SentryFlow_GiveClusterNotice_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GiveAuditLog",
+			Handler:       _SentryFlow_GiveAuditLog_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SyncClusterSnapshot",
+			Handler:       _SentryFlow_SyncClusterSnapshot_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "sentryflow.proto",
 }
@@ -0,0 +1,441 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"Agent/config"
+	"Agent/k8s"
+	"Agent/processor"
+	"Agent/types"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// parseEnvoyAddress Function that splits an Envoy address into IP and port
+// (waypoints report internal addresses like "envoy://connect_originate/10.0.0.1:8080")
+func parseEnvoyAddress(addr string) (string, string) {
+	addr = strings.TrimSpace(addr)
+	if strings.HasPrefix(addr, "envoy://") {
+		addr = addr[strings.LastIndex(addr, "/")+1:]
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", ""
+	}
+
+	return host, port
+}
+
+// parseUpstreamCluster Function that returns the service of an Envoy upstream cluster
+// (e.g., "outbound|8080||reviews.default.svc.cluster.local" or "inbound-vip|8080|http|reviews.default.svc.cluster.local")
+func parseUpstreamCluster(cluster string) (string, string, string) {
+	parts := strings.Split(cluster, "|")
+	if len(parts) != 4 {
+		return "", "", ""
+	}
+
+	hostParts := strings.Split(parts[3], ".")
+	if len(hostParts) < 3 || hostParts[2] != "svc" {
+		return "", "", ""
+	}
+
+	return hostParts[1], hostParts[0], parts[1]
+}
+
+// resolveAmbientSource Function that attributes a request relayed by a ztunnel or waypoint to the original client
+func resolveAmbientSource(src types.K8sResource, srcIP, forwardedFor string) (types.K8sResource, string) {
+	if src.Type != types.K8sResourceTypePod || !types.IsAmbientProxy(src.Labels) {
+		return src, srcIP
+	}
+
+	// The first address of X-Forwarded-For is the original client
+	client := strings.TrimSpace(strings.Split(forwardedFor, ",")[0])
+	if client == "" || client == "-" {
+		return src, srcIP
+	}
+
+	if res, ok := k8s.LookupWorkload(client); ok {
+		return res, client
+	}
+
+	return src, srcIP
+}
+
+// resolveAmbientDestination Function that attributes a request forwarded by a waypoint to the real destination
+func resolveAmbientDestination(dst types.K8sResource, dstIP, dstPort, upstreamHost, upstreamCluster string) (types.K8sResource, string, string) {
+	if dst.Type != types.K8sResourceTypeUnknown && !types.IsAmbientProxy(dst.Labels) {
+		return dst, dstIP, dstPort
+	}
+
+	// Waypoints hand requests to ztunnel through an internal listener
+	if ip, port := parseEnvoyAddress(upstreamHost); ip != "" {
+		if res, ok := k8s.LookupWorkload(ip); ok {
+			return res, ip, port
+		}
+	}
+
+	// Otherwise, fall back to the service that the upstream cluster points at
+	if namespace, name, port := parseUpstreamCluster(upstreamCluster); name != "" {
+		if res := k8s.LookupServiceByName(namespace, name); res.Type != types.K8sResourceTypeUnknown {
+			return res, dstIP, port
+		}
+	}
+
+	return dst, dstIP, dstPort
+}
+
+// == //
+
+// ztunnel metrics that are turned into connection logs
+const (
+	ztunnelConnectionsOpened = "istio_tcp_connections_opened_total"
+	ztunnelSentBytes         = "istio_tcp_sent_bytes_total"
+	ztunnelReceivedBytes     = "istio_tcp_received_bytes_total"
+)
+
+// ztunnelSample structure
+type ztunnelSample struct {
+	name   string
+	labels map[string]string
+	value  float64
+}
+
+// ztunnelSeries structure that accumulates the deltas of one connection series
+type ztunnelSeries struct {
+	cluster       string
+	labels        map[string]string
+	connections   uint64
+	sentBytes     uint64
+	receivedBytes uint64
+}
+
+// ZtunnelScraper structure
+type ZtunnelScraper struct {
+	client   *http.Client
+	counters map[string]float64 // key: ztunnel IP/metric/series, last counter value
+	stopChan chan struct{}
+}
+
+// newZtunnelScraper Function
+func newZtunnelScraper() *ZtunnelScraper {
+	return &ZtunnelScraper{
+		client:   &http.Client{Timeout: 5 * time.Second},
+		counters: make(map[string]float64),
+		stopChan: make(chan struct{}),
+	}
+}
+
+// run Function that periodically scrapes every ztunnel (on the leader only, so connections are reported once)
+func (zs *ZtunnelScraper) run() {
+	period := time.Duration(config.GlobalConfig.ZtunnelScrapePeriod) * time.Second
+	if period <= 0 {
+		period = 15 * time.Second
+	}
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	log.Printf("[Collector] Scraping ztunnel metrics every %s", period)
+
+	for {
+		select {
+		case <-ticker.C:
+			zs.scrapeAll()
+		case <-zs.stopChan:
+			return
+		}
+	}
+}
+
+// scrapeAll Function
+func (zs *ZtunnelScraper) scrapeAll() {
+	series := make(map[string]*ztunnelSeries)
+	alive := make(map[string]bool)
+
+	for addr, cluster := range k8s.ListZtunnelAddresses() {
+		samples, err := zs.scrape(addr)
+		if err != nil {
+			log.Printf("[Collector] Failed to scrape ztunnel %s: %v", addr, err)
+			continue
+		}
+
+		for _, sample := range samples {
+			if !keepZtunnelSample(sample.labels) {
+				continue
+			}
+
+			seriesKey := cluster + "/" + ztunnelSeriesKey(sample.labels)
+			counterKey := fmt.Sprintf("%s/%s/%s", addr, sample.name, seriesKey)
+			alive[counterKey] = true
+
+			prev, found := zs.counters[counterKey]
+			zs.counters[counterKey] = sample.value
+			if !found {
+				continue // the first scrape only sets the baseline
+			}
+
+			// counters restart from zero when a ztunnel restarts
+			delta := sample.value - prev
+			if delta < 0 {
+				delta = sample.value
+			}
+			if delta == 0 {
+				continue
+			}
+
+			s, ok := series[seriesKey]
+			if !ok {
+				s = &ztunnelSeries{cluster: cluster, labels: sample.labels}
+				series[seriesKey] = s
+			}
+
+			switch sample.name {
+			case ztunnelConnectionsOpened:
+				s.connections += uint64(delta)
+			case ztunnelSentBytes:
+				s.sentBytes += uint64(delta)
+			case ztunnelReceivedBytes:
+				s.receivedBytes += uint64(delta)
+			}
+		}
+	}
+
+	// forget the series of ztunnels that are gone
+	for key := range zs.counters {
+		if !alive[key] {
+			delete(zs.counters, key)
+		}
+	}
+
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	for _, s := range series {
+		processor.InsertConnectionLog(generateConnectionLogFromZtunnel(s, timeStamp))
+	}
+}
+
+// scrape Function that fetches the Prometheus metrics of a ztunnel
+func (zs *ZtunnelScraper) scrape(addr string) ([]ztunnelSample, error) {
+	url := fmt.Sprintf("http://%s/metrics", net.JoinHostPort(addr, config.GlobalConfig.ZtunnelMetricsPort))
+
+	resp, err := zs.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return parseZtunnelMetrics(resp.Body)
+}
+
+// parseZtunnelMetrics Function that reads the TCP metrics from the Prometheus text format
+func parseZtunnelMetrics(r io.Reader) ([]ztunnelSample, error) {
+	samples := []ztunnelSample{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name := line
+		if idx := strings.IndexAny(line, "{ "); idx >= 0 {
+			name = line[:idx]
+		}
+		if name != ztunnelConnectionsOpened && name != ztunnelSentBytes && name != ztunnelReceivedBytes {
+			continue
+		}
+
+		labels := make(map[string]string)
+		rest := strings.TrimSpace(line[len(name):])
+
+		if strings.HasPrefix(rest, "{") {
+			end, err := parsePrometheusLabels(rest, labels)
+			if err != nil {
+				return nil, err
+			}
+			rest = strings.TrimSpace(rest[end:])
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			continue
+		}
+
+		samples = append(samples, ztunnelSample{name: name, labels: labels, value: value})
+	}
+
+	return samples, scanner.Err()
+}
+
+// parsePrometheusLabels Function that parses '{key="value",...}' and returns the index after '}'
+func parsePrometheusLabels(text string, labels map[string]string) (int, error) {
+	i := 1 // skip '{'
+
+	for i < len(text) {
+		for i < len(text) && (text[i] == ' ' || text[i] == ',') {
+			i++
+		}
+		if i < len(text) && text[i] == '}' {
+			return i + 1, nil
+		}
+
+		eq := strings.IndexByte(text[i:], '=')
+		if eq < 0 || i+eq+1 >= len(text) || text[i+eq+1] != '"' {
+			return 0, fmt.Errorf("malformed labels: %s", text)
+		}
+		key := strings.TrimSpace(text[i : i+eq])
+		i += eq + 2
+
+		var value strings.Builder
+		for ; i < len(text) && text[i] != '"'; i++ {
+			if text[i] == '\\' && i+1 < len(text) {
+				i++
+				switch text[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(text[i])
+				}
+				continue
+			}
+			value.WriteByte(text[i])
+		}
+		if i >= len(text) {
+			return 0, fmt.Errorf("malformed labels: %s", text)
+		}
+		i++ // skip closing '"'
+
+		labels[key] = value.String()
+	}
+
+	return 0, fmt.Errorf("malformed labels: %s", text)
+}
+
+// keepZtunnelSample Function that keeps one report per connection
+// (both ends report it, so the source report is used only when the destination is outside the mesh)
+func keepZtunnelSample(labels map[string]string) bool {
+	switch labels["reporter"] {
+	case "destination":
+		return true
+	case "source":
+		return isUnknownLabel(labels["destination_workload"])
+	}
+	return false
+}
+
+// ztunnelSeriesKey Function that identifies a series regardless of the reporting ztunnel
+func ztunnelSeriesKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		sb.WriteString(key)
+		sb.WriteByte('=')
+		sb.WriteString(labels[key])
+		sb.WriteByte(',')
+	}
+
+	return sb.String()
+}
+
+// isUnknownLabel Function
+func isUnknownLabel(value string) bool {
+	return value == "" || value == "unknown"
+}
+
+// generateConnectionLogFromZtunnel Function
+func generateConnectionLogFromZtunnel(s *ztunnelSeries, timeStamp string) *protobuf.ConnectionLog {
+	labels := s.labels
+
+	// The reporting ztunnel runs in the cluster of the destination (or of the source for outside destinations),
+	// Istio cluster IDs only tell whether the other side is in another cluster
+	srcCluster, dstCluster := s.cluster, s.cluster
+	if src, dst := labels["source_cluster"], labels["destination_cluster"]; !isUnknownLabel(src) && !isUnknownLabel(dst) && src != dst {
+		if labels["reporter"] == "destination" {
+			srcCluster = src
+		} else {
+			dstCluster = dst
+		}
+	}
+
+	srcType := "Workload"
+	if isUnknownLabel(labels["source_workload"]) {
+		srcType = "Unknown"
+	}
+
+	// Destinations outside the mesh only have the service they were addressed to
+	dstType, dstName, dstNamespace := "Workload", labels["destination_workload"], labels["destination_workload_namespace"]
+	if isUnknownLabel(dstName) {
+		dstType, dstName, dstNamespace = "Service", labels["destination_service_name"], labels["destination_service_namespace"]
+		if isUnknownLabel(dstName) {
+			dstType, dstName = "Unknown", labels["destination_service"]
+		}
+	}
+
+	return &protobuf.ConnectionLog{
+		Id:        0, // @todo zero for now
+		TimeStamp: timeStamp,
+
+		SrcCluster:   srcCluster,
+		SrcNamespace: labels["source_workload_namespace"],
+		SrcName:      labels["source_workload"],
+		SrcLabel:     workloadLabels(labels, "source"),
+		SrcType:      srcType,
+
+		DstCluster:   dstCluster,
+		DstNamespace: dstNamespace,
+		DstName:      dstName,
+		DstLabel:     workloadLabels(labels, "destination"),
+		DstType:      dstType,
+		DstService:   labels["destination_service_name"],
+
+		Protocol:      labels["request_protocol"],
+		Reporter:      "ztunnel",
+		Connections:   s.connections,
+		SentBytes:     s.sentBytes,
+		ReceivedBytes: s.receivedBytes,
+	}
+}
+
+// workloadLabels Function that returns the app and version labels of a side of a connection
+func workloadLabels(labels map[string]string, side string) map[string]string {
+	ret := make(map[string]string)
+	if app := labels[side+"_app"]; !isUnknownLabel(app) {
+		ret["app"] = app
+	}
+	if version := labels[side+"_version"]; !isUnknownLabel(version) {
+		ret["version"] = version
+	}
+	return ret
+}
+
+// == //
@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"Agent/config"
+	"Agent/k8s"
+	"Agent/processor"
+	"Agent/types"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/grpc"
+)
+
+// == //
+
+// auditWebhookMaxBytes limits the size of an EventList posted by the API server
+const auditWebhookMaxBytes = 32 << 20
+
+// AuditWebhookServer Structure
+type AuditWebhookServer struct {
+	collectorInterface
+
+	server *http.Server
+	token  []byte // bearer token that requests must carry (nil: client certificates only)
+
+	verbs     map[string]bool
+	resources map[string]bool
+}
+
+// newAuditWebhookServer Function
+// (audit events feed a security stream, so the webhook is only served over TLS to an authenticated API server)
+func newAuditWebhookServer() (*AuditWebhookServer, error) {
+	ret := &AuditWebhookServer{
+		verbs:     auditFilter(config.GlobalConfig.AuditVerbs),
+		resources: auditFilter(config.GlobalConfig.AuditResources),
+	}
+
+	if config.GlobalConfig.AuditWebhookCert == "" || config.GlobalConfig.AuditWebhookKey == "" {
+		return nil, errors.New("the audit webhook requires a TLS certificate and key (auditWebhookCert, auditWebhookKey)")
+	}
+	if config.GlobalConfig.AuditWebhookCA == "" && config.GlobalConfig.AuditWebhookToken == "" {
+		return nil, errors.New("the audit webhook requires a CA for client certificates (auditWebhookCA) or a bearer token (auditWebhookToken)")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if config.GlobalConfig.AuditWebhookCA != "" {
+		caPEM, err := os.ReadFile(config.GlobalConfig.AuditWebhookCA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", config.GlobalConfig.AuditWebhookCA, err)
+		}
+
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate found in %s", config.GlobalConfig.AuditWebhookCA)
+		}
+
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	if config.GlobalConfig.AuditWebhookToken != "" {
+		token, err := os.ReadFile(config.GlobalConfig.AuditWebhookToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", config.GlobalConfig.AuditWebhookToken, err)
+		}
+
+		ret.token = []byte(strings.TrimSpace(string(token)))
+		if len(ret.token) == 0 {
+			return nil, fmt.Errorf("%s holds no token", config.GlobalConfig.AuditWebhookToken)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/audit", ret.handleEventList)
+
+	ret.server = &http.Server{
+		Addr:              fmt.Sprintf("%s:%s", config.GlobalConfig.CollectorAddr, config.GlobalConfig.AuditWebhookPort),
+		Handler:           mux,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return ret, nil
+}
+
+// auditFilter Function that returns the set of values configured in a comma-separated filter
+func auditFilter(filter string) map[string]bool {
+	ret := make(map[string]bool)
+	for _, value := range strings.Split(filter, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			ret[value] = true
+		}
+	}
+	return ret
+}
+
+// registerService Function (audit events are posted over HTTP, so nothing is served over gRPC)
+func (audit *AuditWebhookServer) registerService(server *grpc.Server) {}
+
+// startStream Function that serves the audit webhook until the collector stops
+func (audit *AuditWebhookServer) startStream() {
+	log.Printf("[AuditWebhook] Listening for audit events (%s/audit)", audit.server.Addr)
+
+	err := audit.server.ListenAndServeTLS(config.GlobalConfig.AuditWebhookCert, config.GlobalConfig.AuditWebhookKey)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("[AuditWebhook] Failed to serve the audit webhook: %v", err)
+	}
+}
+
+// stopStream Function
+func (audit *AuditWebhookServer) stopStream() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := audit.server.Shutdown(ctx); err != nil {
+		log.Printf("[AuditWebhook] Failed to stop the audit webhook: %v", err)
+	}
+}
+
+// handleEventList Function that receives an EventList from the API server
+func (audit *AuditWebhookServer) handleEventList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if audit.token != nil {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(token), audit.token) != 1 {
+			log.Printf("[AuditWebhook] Rejected an EventList from %s (invalid bearer token)", r.RemoteAddr)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	eventList := types.AuditEventList{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, auditWebhookMaxBytes)).Decode(&eventList); err != nil {
+		log.Printf("[AuditWebhook] Failed to decode an EventList: %v", err)
+		http.Error(w, "invalid EventList", http.StatusBadRequest)
+		return
+	}
+
+	for idx := range eventList.Items {
+		event := &eventList.Items[idx]
+		if !audit.keepEvent(event) {
+			continue
+		}
+		processor.InsertAuditLog(generateAuditLog(event))
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// keepEvent Function that checks if an audit event is one of the selected verbs on the selected resources
+func (audit *AuditWebhookServer) keepEvent(event *types.AuditEvent) bool {
+	// Each request is reported once, when its response is complete
+	if event.Stage != "ResponseComplete" && event.Stage != "Panic" {
+		return false
+	}
+
+	if len(audit.verbs) > 0 && !audit.verbs[event.Verb] {
+		return false
+	}
+
+	if len(audit.resources) > 0 {
+		if event.ObjectRef == nil {
+			return false
+		}
+
+		resource := event.ObjectRef.Resource
+		if !audit.resources[resource] && !audit.resources[resource+"/"+event.ObjectRef.Subresource] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// generateAuditLog Function
+func generateAuditLog(event *types.AuditEvent) *protobuf.AuditLog {
+	// Actions taken on behalf of another user belong to the impersonated user
+	user := event.User
+	if event.ImpersonatedUser != nil {
+		user = *event.ImpersonatedUser
+	}
+
+	// The audit webhook is only served when Agent watches a single cluster
+	auditCluster, _ := k8s.SingleClusterName()
+
+	auditLog := &protobuf.AuditLog{
+		Id:        0, // @todo zero for now
+		TimeStamp: strconv.FormatInt(event.StageTimestamp.Unix(), 10),
+		AuditID:   event.AuditID,
+		Stage:     event.Stage,
+
+		Cluster:   auditCluster,
+		User:      user.Username,
+		Groups:    user.Groups,
+		UserAgent: event.UserAgent,
+		SourceIPs: event.SourceIPs,
+
+		SrcType: "User",
+
+		Verb:       event.Verb,
+		RequestURI: event.RequestURI,
+	}
+
+	// Service accounts are attributed to the workloads running as them
+	if namespace, name, ok := k8s.ParseServiceAccountUsername(user.Username); ok {
+		auditLog.SrcNamespace = namespace
+		auditLog.SrcName = name
+		auditLog.SrcType = "ServiceAccount"
+		auditLog.ServiceAccount = name
+
+		if workload, ok := k8s.LookupServiceAccountWorkload(user, event.SourceIPs); ok {
+			auditLog.Cluster = workload.Cluster
+			auditLog.SrcName = workload.Name
+			auditLog.SrcLabel = workload.Labels
+			auditLog.SrcType = types.K8sResourceTypeToString(workload.Type)
+		}
+	}
+
+	if ref := event.ObjectRef; ref != nil {
+		auditLog.ApiGroup = ref.APIGroup
+		auditLog.Resource = ref.Resource
+		auditLog.Subresource = ref.Subresource
+		auditLog.Namespace = ref.Namespace
+		auditLog.Name = ref.Name
+	}
+
+	if status := event.ResponseStatus; status != nil {
+		auditLog.ResponseCode = status.Code
+		auditLog.ResponseReason = status.Reason
+	}
+
+	return auditLog
+}
+
+// == //
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"sync"
 
 	"Agent/config"
 
@@ -27,6 +28,12 @@ type ColHandler struct {
 	colService net.Listener
 	grpcServer *grpc.Server
 	collectors []collectorInterface
+
+	// Collectors that pull the telemetry of the whole cluster run only on the leader
+	leaderLock      sync.Mutex
+	leading         bool
+	hubbleCollector *HubbleCollector
+	ztunnelScraper  *ZtunnelScraper
 }
 
 // NewCollectorHandler Function
@@ -41,6 +48,17 @@ func NewCollectorHandler() *ColHandler {
 
 // StartCollector Function
 func StartCollector() bool {
+	// The audit webhook refuses to start without TLS and client authentication
+	var auditWebhook *AuditWebhookServer
+	if config.GlobalConfig.AuditWebhook {
+		webhook, err := newAuditWebhookServer()
+		if err != nil {
+			log.Printf("[Collector] Failed to initialize the audit webhook: %v", err)
+			return false
+		}
+		auditWebhook = webhook
+	}
+
 	// Make a string with the given collector address and port
 	collectorService := fmt.Sprintf("%s:%s", config.GlobalConfig.CollectorAddr, config.GlobalConfig.CollectorPort)
 
@@ -65,6 +83,11 @@ func StartCollector() bool {
 	ColH.collectors = append(ColH.collectors, newEnvoyAccessLogsServer())
 	ColH.collectors = append(ColH.collectors, newEnvoyMetricsServer())
 
+	// initialize audit webhook for API server audit events
+	if auditWebhook != nil {
+		ColH.collectors = append(ColH.collectors, auditWebhook)
+	}
+
 	// register services
 	for _, col := range ColH.collectors {
 		col.registerService(ColH.grpcServer)
@@ -77,11 +100,71 @@ func StartCollector() bool {
 
 	log.Print("[Collector] Serving Collector gRPC services")
 
+	// start collectors that pull telemetry from remote endpoints
+	for _, col := range ColH.collectors {
+		if streamCol, ok := col.(streamCollectorInterface); ok {
+			go streamCol.startStream()
+		}
+	}
+
 	return true
 }
 
+// StartLeaderCollectors Function that starts the collectors that pull the telemetry of the whole cluster
+// (replicas that are not leading would report the same flows again)
+func StartLeaderCollectors() {
+	ColH.leaderLock.Lock()
+	defer ColH.leaderLock.Unlock()
+
+	if ColH.leading {
+		return
+	}
+	ColH.leading = true
+
+	// Follow Cilium flows from Hubble Relay
+	if config.GlobalConfig.HubbleEnabled {
+		ColH.hubbleCollector = newHubbleCollector()
+		go ColH.hubbleCollector.startStream()
+	}
+
+	// Scrape every ztunnel for L4 traffic in the ambient mesh
+	if config.GlobalConfig.AmbientMode {
+		ColH.ztunnelScraper = newZtunnelScraper()
+		go ColH.ztunnelScraper.run()
+	}
+}
+
+// StopLeaderCollectors Function that stops the collectors of the leader
+func StopLeaderCollectors() {
+	ColH.leaderLock.Lock()
+	defer ColH.leaderLock.Unlock()
+
+	if !ColH.leading {
+		return
+	}
+	ColH.leading = false
+
+	if ColH.hubbleCollector != nil {
+		ColH.hubbleCollector.stopStream()
+		ColH.hubbleCollector = nil
+	}
+
+	if ColH.ztunnelScraper != nil {
+		close(ColH.ztunnelScraper.stopChan)
+		ColH.ztunnelScraper = nil
+	}
+}
+
 // StopCollector Function
 func StopCollector() bool {
+	StopLeaderCollectors()
+
+	for _, col := range ColH.collectors {
+		if streamCol, ok := col.(streamCollectorInterface); ok {
+			streamCol.stopStream()
+		}
+	}
+
 	ColH.grpcServer.GracefulStop()
 
 	log.Print("[Collector] Gracefully stopped Collector gRPC services")
@@ -7,6 +7,7 @@ import (
 	"log"
 	"strconv"
 
+	"Agent/config"
 	"Agent/k8s"
 	"Agent/processor"
 	"Agent/types"
@@ -61,7 +62,7 @@ func (evyMetrics *EnvoyMetricsServer) registerService(server *grpc.Server) {
 // == //
 
 // generateAPILogsFromEnvoy Function
-func generateAPILogsFromEnvoy(entry *envoyAccLogsData.HTTPAccessLogEntry) *protobuf.APILog {
+func generateAPILogsFromEnvoy(entry *envoyAccLogsData.HTTPAccessLogEntry, proxy types.K8sResource) *protobuf.APILog {
 	comm := entry.GetCommonProperties()
 	timeStamp := comm.GetStartTime().Seconds
 
@@ -70,19 +71,45 @@ func generateAPILogsFromEnvoy(entry *envoyAccLogsData.HTTPAccessLogEntry) *proto
 	srcPort := strconv.Itoa(int(srcInform.GetPortValue()))
 	src := k8s.LookupK8sResource(srcIP)
 
+	request := entry.GetRequest()
+	response := entry.GetResponse()
+
+	// External requests entering through an ingress gateway are attributed to the matching route
+	host := request.GetAuthority()
+	if src.Type == types.K8sResourceTypeUnknown {
+		src = k8s.LookupIngressRoute(host, request.GetPath())
+	}
+
 	dstInform := entry.GetCommonProperties().GetUpstreamRemoteAddress().GetSocketAddress()
 	dstIP := dstInform.GetAddress()
 	dstPort := strconv.Itoa(int(dstInform.GetPortValue()))
 	dst := k8s.LookupK8sResource(dstIP)
 
-	request := entry.GetRequest()
-	response := entry.GetResponse()
+	// Requests relayed by ztunnel or waypoint proxies are attributed to the real workloads
+	if config.GlobalConfig.AmbientMode {
+		src, srcIP = resolveAmbientSource(src, srcIP, request.GetForwardedFor())
+
+		upstreamHost := comm.GetUpstreamRemoteAddress().GetEnvoyInternalAddress().GetEndpointId()
+		dst, dstIP, dstPort = resolveAmbientDestination(dst, dstIP, dstPort, upstreamHost, comm.GetUpstreamCluster())
+	}
+
+	// Loopback addresses are seen by proxies that share the pod of a workload
+	if isLoopbackAddress(srcIP) {
+		src = proxy
+	}
+	if isLoopbackAddress(dstIP) {
+		dst = proxy
+	}
 
 	protocol := entry.GetProtocolVersion().String()
 	method := request.GetRequestMethod().String()
 	path := request.GetPath()
 	resCode := response.GetResponseCode().GetValue()
 
+	// The same request is reported by the sidecars at both ends, Operator pairs the two reports
+	side := reportingSide(comm.GetUpstreamCluster())
+	latency := comm.GetTimeToLastDownstreamTxByte().AsDuration().Nanoseconds()
+
 	envoyAPILog := &protobuf.APILog{
 		Id:        0, // @todo zero for now
 		TimeStamp: strconv.FormatInt(timeStamp, 10),
@@ -94,6 +121,7 @@ func generateAPILogsFromEnvoy(entry *envoyAccLogsData.HTTPAccessLogEntry) *proto
 		SrcIP:        srcIP,
 		SrcPort:      srcPort,
 		SrcType:      types.K8sResourceTypeToString(src.Type),
+		SrcZone:      src.Zone,
 
 		DstCluster:   dst.Cluster,
 		DstNamespace: dst.Namespace,
@@ -102,11 +130,27 @@ func generateAPILogsFromEnvoy(entry *envoyAccLogsData.HTTPAccessLogEntry) *proto
 		DstIP:        dstIP,
 		DstPort:      dstPort,
 		DstType:      types.K8sResourceTypeToString(dst.Type),
+		DstZone:      dst.Zone,
 
 		Protocol:     protocol,
 		Method:       method,
 		Path:         path,
 		ResponseCode: int32(resCode),
+		Host:         host,
+
+		RequestID:  request.GetRequestId(),
+		ReportedBy: side,
+
+		// traceparent is only there when the proxy is told to log it (additional_request_headers_to_log)
+		TraceID:   traceParentID(request.GetRequestHeaders()["traceparent"]),
+		StartTime: comm.GetStartTime().AsTime().UnixNano(),
+	}
+
+	switch side {
+	case "client":
+		envoyAPILog.ClientLatency = latency
+	case "server":
+		envoyAPILog.ServerLatency = latency
 	}
 
 	return envoyAPILog
@@ -114,6 +158,8 @@ func generateAPILogsFromEnvoy(entry *envoyAccLogsData.HTTPAccessLogEntry) *proto
 
 // StreamAccessLogs Function
 func (evyAccLogs *EnvoyAccessLogsServer) StreamAccessLogs(stream envoyAccLogs.AccessLogService_StreamAccessLogsServer) error {
+	proxy, _ := identifyEnvoyProxy(stream.Context(), nil)
+
 	for {
 		event, err := stream.Recv()
 		if err == io.EOF {
@@ -123,9 +169,14 @@ func (evyAccLogs *EnvoyAccessLogsServer) StreamAccessLogs(stream envoyAccLogs.Ac
 			return err
 		}
 
+		// Only the first message of a stream identifies the proxy
+		if identifier := event.GetIdentifier(); identifier != nil {
+			proxy, _ = identifyEnvoyProxy(stream.Context(), identifier.GetNode())
+		}
+
 		if event.GetHttpLogs() != nil {
 			for _, entry := range event.GetHttpLogs().LogEntry {
-				envoyAPILog := generateAPILogsFromEnvoy(entry)
+				envoyAPILog := generateAPILogsFromEnvoy(entry, proxy)
 				processor.InsertAPILog(envoyAPILog)
 			}
 		}
@@ -135,14 +186,14 @@ func (evyAccLogs *EnvoyAccessLogsServer) StreamAccessLogs(stream envoyAccLogs.Ac
 // == //
 
 // generateMetricsFromEnvoy Function
-func generateMetricsFromEnvoy(event *envoyMetrics.StreamMetricsMessage, metaData map[string]interface{}) *protobuf.EnvoyMetrics {
+func generateMetricsFromEnvoy(event *envoyMetrics.StreamMetricsMessage, proxy types.K8sResource, proxyIP string) *protobuf.EnvoyMetrics {
 	envoyMetrics := &protobuf.EnvoyMetrics{
 		TimeStamp: "",
 
-		Namespace: metaData["NAMESPACE"].(string),
-		Name:      metaData["NAME"].(string),
-		IPAddress: metaData["INSTANCE_IPS"].(string),
-		Labels:    k8s.LookupK8sResource(metaData["INSTANCE_IPS"].(string)).Labels,
+		Namespace: proxy.Namespace,
+		Name:      proxy.Name,
+		IPAddress: proxyIP,
+		Labels:    proxy.Labels,
 
 		Metrics: make(map[string]*protobuf.MetricValue),
 	}
@@ -218,8 +269,8 @@ func (evyMetrics *EnvoyMetricsServer) StreamMetrics(stream envoyMetrics.MetricsS
 
 	identifier := event.GetIdentifier()
 	if identifier != nil {
-		metaData := identifier.GetNode().GetMetadata().AsMap()
-		envoyMetrics := generateMetricsFromEnvoy(event, metaData)
+		proxy, proxyIP := identifyEnvoyProxy(stream.Context(), identifier.GetNode())
+		envoyMetrics := generateMetricsFromEnvoy(event, proxy, proxyIP)
 		processor.InsertMetrics(envoyMetrics)
 	}
 
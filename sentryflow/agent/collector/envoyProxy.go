@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"Agent/config"
+	"Agent/k8s"
+	"Agent/types"
+
+	envoyCore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+
+	"google.golang.org/grpc/peer"
+)
+
+// == //
+
+// lookupNodeMetadata Function that returns the first non-empty value among the given node metadata keys
+func lookupNodeMetadata(metaData map[string]interface{}, keys string) string {
+	for _, key := range strings.Split(keys, ",") {
+		if value, ok := metaData[strings.TrimSpace(key)].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// peerIPAddress Function that returns the IP address of the peer of a gRPC stream
+func peerIPAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+// identifyEnvoyProxy Function that identifies the Envoy proxy sending telemetry on a stream
+// (node metadata is used when the data plane provides it, the peer address of the stream otherwise)
+func identifyEnvoyProxy(ctx context.Context, node *envoyCore.Node) (types.K8sResource, string) {
+	metaData := node.GetMetadata().AsMap()
+
+	namespace := lookupNodeMetadata(metaData, config.GlobalConfig.EnvoyNamespaceKeys)
+	name := lookupNodeMetadata(metaData, config.GlobalConfig.EnvoyNameKeys)
+
+	// Istio lists every IP address of a pod in INSTANCE_IPS, the first one is enough
+	ipAddr := strings.TrimSpace(strings.Split(lookupNodeMetadata(metaData, config.GlobalConfig.EnvoyIPKeys), ",")[0])
+	if ipAddr == "" {
+		ipAddr = peerIPAddress(ctx)
+	}
+
+	proxy := k8s.LookupK8sResource(ipAddr)
+
+	// Proxies outside of the watched clusters are only known by their metadata
+	// (and by their cluster only if Agent watches a single one)
+	if proxy.Type == types.K8sResourceTypeUnknown && namespace != "" && name != "" {
+		if cluster, ok := k8s.SingleClusterName(); ok {
+			proxy.Cluster = cluster
+		}
+		proxy.Namespace = namespace
+		proxy.Name = name
+	}
+
+	return proxy, ipAddr
+}
+
+// reportingSide Function that tells which end of a request a sidecar proxy stands at from its upstream cluster
+// (Istio names the clusters of sidecars "outbound|port|subset|host" and "inbound|port|subset|host")
+func reportingSide(upstreamCluster string) string {
+	switch {
+	case strings.HasPrefix(upstreamCluster, "outbound|"):
+		return "client"
+	case strings.HasPrefix(upstreamCluster, "inbound|"):
+		return "server"
+	}
+	return ""
+}
+
+// traceParentID Function that returns the trace ID in a W3C traceparent header ("version-traceid-spanid-flags")
+func traceParentID(traceParent string) string {
+	parts := strings.Split(strings.TrimSpace(traceParent), "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || strings.Trim(parts[1], "0") == "" {
+		return ""
+	}
+
+	traceID := strings.ToLower(parts[1])
+	if strings.Trim(traceID, "0123456789abcdef") != "" {
+		return ""
+	}
+
+	return traceID
+}
+
+// isLoopbackAddress Function that checks if an address only makes sense inside the pod of a proxy
+func isLoopbackAddress(ipAddr string) bool {
+	ip := net.ParseIP(ipAddr)
+	return ip != nil && ip.IsLoopback()
+}
+
+// == //
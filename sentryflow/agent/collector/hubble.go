@@ -0,0 +1,420 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"Agent/collector/hubble"
+	"Agent/config"
+	"Agent/k8s"
+	"Agent/processor"
+	"Agent/types"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// == //
+
+// hubbleRetryPeriod is the time to wait before reconnecting to Hubble
+const hubbleRetryPeriod = 5 * time.Second
+
+// HubbleCollector Structure
+type HubbleCollector struct {
+	collectorInterface
+
+	addr     string
+	stopChan chan struct{}
+}
+
+// newHubbleCollector Function
+func newHubbleCollector() *HubbleCollector {
+	ret := &HubbleCollector{
+		addr:     config.GlobalConfig.HubbleAddr,
+		stopChan: make(chan struct{}),
+	}
+	return ret
+}
+
+// hubbleCredentials Function that returns the transport credentials for Hubble Relay
+func hubbleCredentials() (credentials.TransportCredentials, error) {
+	if !config.GlobalConfig.HubbleTLS {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: config.GlobalConfig.HubbleTLSName,
+	}
+
+	if config.GlobalConfig.HubbleTLSCA != "" {
+		caPEM, err := os.ReadFile(config.GlobalConfig.HubbleTLSCA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", config.GlobalConfig.HubbleTLSCA, err)
+		}
+
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate found in %s", config.GlobalConfig.HubbleTLSCA)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	// Hubble Relay may require a client certificate (mTLS)
+	if config.GlobalConfig.HubbleTLSCert != "" {
+		cert, err := tls.LoadX509KeyPair(config.GlobalConfig.HubbleTLSCert, config.GlobalConfig.HubbleTLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client key pair: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	} else if config.GlobalConfig.HubbleTLSKey != "" {
+		return nil, errors.New("hubbleTLSKey is given without hubbleTLSCert")
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// registerService Function (flows are pulled from Hubble, so nothing is served)
+func (hubbleCol *HubbleCollector) registerService(server *grpc.Server) {}
+
+// startStream Function that follows the flows of Hubble until the collector stops
+// (Hubble Relay serves the flows of the whole cluster, so only the leader follows them)
+func (hubbleCol *HubbleCollector) startStream() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-hubbleCol.stopChan
+		cancel()
+	}()
+
+	log.Printf("[Hubble] Started to follow flows from %s", hubbleCol.addr)
+
+	for {
+		err := hubbleCol.followFlows(ctx)
+		if ctx.Err() != nil {
+			log.Print("[Hubble] Stopped following flows")
+			return
+		}
+
+		log.Printf("[Hubble] Lost the flow stream from %s, reconnecting in %v: %v", hubbleCol.addr, hubbleRetryPeriod, err)
+
+		select {
+		case <-hubbleCol.stopChan:
+			log.Print("[Hubble] Stopped following flows")
+			return
+		case <-time.After(hubbleRetryPeriod):
+		}
+	}
+}
+
+// stopStream Function
+func (hubbleCol *HubbleCollector) stopStream() {
+	close(hubbleCol.stopChan)
+}
+
+// followFlows Function that receives flows from Hubble until the stream breaks
+func (hubbleCol *HubbleCollector) followFlows(ctx context.Context) error {
+	creds, err := hubbleCredentials()
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(hubbleCol.addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := hubble.NewObserverClient(conn)
+
+	stream, err := client.GetFlows(ctx, &hubble.GetFlowsRequest{Follow: true})
+	if err != nil {
+		return err
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		flow := res.GetFlow()
+		if flow == nil {
+			continue
+		}
+
+		switch flow.GetType() {
+		case hubble.FlowType_L7:
+			if hubbleAPILog := generateAPILogFromHubble(flow); hubbleAPILog != nil {
+				processor.InsertAPILog(hubbleAPILog)
+			}
+		case hubble.FlowType_L3_L4:
+			if hubbleConnLog := generateConnectionLogFromHubble(flow); hubbleConnLog != nil {
+				processor.InsertConnectionLog(hubbleConnLog)
+			}
+		}
+	}
+}
+
+// == //
+
+// hubbleResource Function that converts the identity of a Hubble endpoint into a K8sResource
+func hubbleResource(ep *hubble.Endpoint, ipAddr string) types.K8sResource {
+	// Endpoints that are not pods (world, host, remote-node, ...) fall back to the informer caches
+	if ep.GetPodName() == "" {
+		return k8s.LookupK8sResource(ipAddr)
+	}
+
+	ret := types.K8sResource{
+		Cluster:   ep.GetClusterName(),
+		Namespace: ep.GetNamespace(),
+		Name:      ep.GetPodName(),
+		Labels:    hubbleLabels(ep.GetLabels()),
+		Type:      types.K8sResourceTypePod,
+	}
+
+	// Hubble does not know about zones, the informer caches do
+	if res := k8s.LookupK8sResource(ipAddr); res.Type == types.K8sResourceTypePod {
+		ret.Zone = res.Zone
+		if ret.Cluster == "" || ret.Cluster == "default" {
+			ret.Cluster = res.Cluster
+		}
+	}
+
+	// Hubble is only followed when Agent watches a single cluster
+	if ret.Cluster == "" {
+		ret.Cluster, _ = k8s.SingleClusterName()
+	}
+
+	return ret
+}
+
+// hubbleLabels Function that converts Cilium labels ("k8s:app=foo") into Kubernetes labels
+func hubbleLabels(labels []string) map[string]string {
+	ret := make(map[string]string)
+
+	for _, label := range labels {
+		source, kv, found := strings.Cut(label, ":")
+		if !found || source != "k8s" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(kv, "=")
+
+		// Labels that Cilium derives from the pod itself are not part of its metadata
+		if strings.HasPrefix(key, "io.cilium.k8s.") || strings.HasPrefix(key, "io.kubernetes.pod.") {
+			continue
+		}
+
+		ret[key] = value
+	}
+
+	return ret
+}
+
+// hubblePorts Function that returns the source and destination ports of a flow
+func hubblePorts(flow *hubble.Flow) (string, string) {
+	l4 := flow.GetL4()
+
+	switch {
+	case l4.GetTCP() != nil:
+		return strconv.Itoa(int(l4.GetTCP().GetSourcePort())), strconv.Itoa(int(l4.GetTCP().GetDestinationPort()))
+	case l4.GetUDP() != nil:
+		return strconv.Itoa(int(l4.GetUDP().GetSourcePort())), strconv.Itoa(int(l4.GetUDP().GetDestinationPort()))
+	case l4.GetSCTP() != nil:
+		return strconv.Itoa(int(l4.GetSCTP().GetSourcePort())), strconv.Itoa(int(l4.GetSCTP().GetDestinationPort()))
+	}
+
+	return "", ""
+}
+
+// hubbleProtocol Function that returns the L4 protocol of a flow
+func hubbleProtocol(flow *hubble.Flow) string {
+	l4 := flow.GetL4()
+
+	switch {
+	case l4.GetTCP() != nil:
+		return "TCP"
+	case l4.GetUDP() != nil:
+		return "UDP"
+	case l4.GetSCTP() != nil:
+		return "SCTP"
+	case l4.GetICMPv4() != nil:
+		return "ICMPv4"
+	case l4.GetICMPv6() != nil:
+		return "ICMPv6"
+	}
+
+	return "Unknown"
+}
+
+// hubbleHeader Function that returns the value of an HTTP header in a flow
+func hubbleHeader(http *hubble.HTTP, key string) string {
+	for _, header := range http.GetHeaders() {
+		if strings.EqualFold(header.GetKey(), key) {
+			return header.GetValue()
+		}
+	}
+	return ""
+}
+
+// == //
+
+// generateAPILogFromHubble Function
+func generateAPILogFromHubble(flow *hubble.Flow) *protobuf.APILog {
+	l7 := flow.GetL7()
+
+	// Only responses carry the status, and a response goes from the server back to the client
+	if l7.GetType() != hubble.L7FlowType_RESPONSE {
+		return nil
+	}
+
+	srcIP, dstIP := flow.GetIP().GetDestination(), flow.GetIP().GetSource()
+	dstPort, srcPort := hubblePorts(flow)
+
+	src := hubbleResource(flow.GetDestination(), srcIP)
+	dst := hubbleResource(flow.GetSource(), dstIP)
+
+	hubbleAPILog := &protobuf.APILog{
+		Id:        0, // @todo zero for now
+		TimeStamp: strconv.FormatInt(flow.GetTime().GetSeconds(), 10),
+
+		SrcCluster:   src.Cluster,
+		SrcNamespace: src.Namespace,
+		SrcName:      src.Name,
+		SrcLabel:     src.Labels,
+		SrcIP:        srcIP,
+		SrcPort:      srcPort,
+		SrcType:      types.K8sResourceTypeToString(src.Type),
+		SrcZone:      src.Zone,
+
+		DstCluster:   dst.Cluster,
+		DstNamespace: dst.Namespace,
+		DstName:      dst.Name,
+		DstLabel:     dst.Labels,
+		DstIP:        dstIP,
+		DstPort:      dstPort,
+		DstType:      types.K8sResourceTypeToString(dst.Type),
+		DstZone:      dst.Zone,
+		DstService:   flow.GetSourceService().GetName(),
+	}
+
+	switch {
+	case l7.GetHttp() != nil:
+		http := l7.GetHttp()
+
+		hubbleAPILog.Protocol = http.GetProtocol()
+		hubbleAPILog.Method = http.GetMethod()
+		hubbleAPILog.ResponseCode = int32(http.GetCode())
+
+		// Hubble reports the full URL of a request
+		if u, err := url.Parse(http.GetUrl()); err == nil {
+			hubbleAPILog.Host = u.Host
+			hubbleAPILog.Path = u.RequestURI()
+		} else {
+			hubbleAPILog.Path = http.GetUrl()
+		}
+
+		if strings.HasPrefix(hubbleHeader(http, "content-type"), "application/grpc") {
+			hubbleAPILog.Protocol = "gRPC"
+		}
+
+		hubbleAPILog.RequestID = hubbleHeader(http, "x-request-id")
+		hubbleAPILog.TraceID = traceParentID(hubbleHeader(http, "traceparent"))
+
+	case l7.GetDns() != nil:
+		dns := l7.GetDns()
+		query := strings.TrimSuffix(dns.GetQuery(), ".")
+
+		hubbleAPILog.Protocol = "DNS"
+		hubbleAPILog.Method = strings.Join(dns.GetQtypes(), ",")
+		hubbleAPILog.Path = query
+		hubbleAPILog.Host = query
+		hubbleAPILog.ResponseCode = int32(dns.GetRcode())
+
+	default:
+		return nil
+	}
+
+	// The L7 proxy sees a request leaving the client and, with policies at both ends, entering the server
+	switch flow.GetTrafficDirection() {
+	case hubble.TrafficDirection_EGRESS:
+		hubbleAPILog.ReportedBy = "client"
+		hubbleAPILog.ClientLatency = int64(l7.GetLatencyNs())
+	case hubble.TrafficDirection_INGRESS:
+		hubbleAPILog.ReportedBy = "server"
+		hubbleAPILog.ServerLatency = int64(l7.GetLatencyNs())
+	}
+
+	// A response is observed when the request ends
+	if flow.GetTime() != nil {
+		hubbleAPILog.StartTime = flow.GetTime().AsTime().UnixNano() - int64(l7.GetLatencyNs())
+	}
+
+	return hubbleAPILog
+}
+
+// generateConnectionLogFromHubble Function
+func generateConnectionLogFromHubble(flow *hubble.Flow) *protobuf.ConnectionLog {
+	if flow.GetVerdict() != hubble.Verdict_FORWARDED || flow.GetIsReply().GetValue() {
+		return nil
+	}
+
+	// A connection between two pods is observed at both ends, so only the egress side is counted
+	// unless the source is outside of the cluster
+	if flow.GetTrafficDirection() == hubble.TrafficDirection_INGRESS && flow.GetSource().GetPodName() != "" {
+		return nil
+	}
+
+	// Only the first packet of a TCP connection opens it
+	if tcp := flow.GetL4().GetTCP(); tcp != nil && (!tcp.GetFlags().GetSYN() || tcp.GetFlags().GetACK()) {
+		return nil
+	}
+
+	srcIP, dstIP := flow.GetIP().GetSource(), flow.GetIP().GetDestination()
+	srcPort, dstPort := hubblePorts(flow)
+
+	src := hubbleResource(flow.GetSource(), srcIP)
+	dst := hubbleResource(flow.GetDestination(), dstIP)
+
+	return &protobuf.ConnectionLog{
+		Id:        0, // @todo zero for now
+		TimeStamp: strconv.FormatInt(flow.GetTime().GetSeconds(), 10),
+
+		SrcCluster:   src.Cluster,
+		SrcNamespace: src.Namespace,
+		SrcName:      src.Name,
+		SrcLabel:     src.Labels,
+		SrcType:      types.K8sResourceTypeToString(src.Type),
+		SrcIP:        srcIP,
+		SrcPort:      srcPort,
+
+		DstCluster:   dst.Cluster,
+		DstNamespace: dst.Namespace,
+		DstName:      dst.Name,
+		DstLabel:     dst.Labels,
+		DstType:      types.K8sResourceTypeToString(dst.Type),
+		DstIP:        dstIP,
+		DstPort:      dstPort,
+		DstService:   flow.GetDestinationService().GetName(),
+
+		Protocol:    hubbleProtocol(flow),
+		Reporter:    "hubble",
+		Connections: 1,
+	}
+}
+
+// == //
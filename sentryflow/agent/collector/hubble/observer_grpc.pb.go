@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Subset of the Hubble Observer API (cilium/api/v1/observer, cilium/api/v1/flow)
+// that SentryFlow Agent consumes. Package, service and field numbers follow the
+// upstream definitions so that the messages stay wire-compatible with Hubble
+// and Hubble Relay; fields that Agent does not read are left out.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.26.0
+// source: observer.proto
+
+package hubble
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Observer_GetFlows_FullMethodName = "/observer.Observer/GetFlows"
+)
+
+// ObserverClient is the client API for Observer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ObserverClient interface {
+	GetFlows(ctx context.Context, in *GetFlowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetFlowsResponse], error)
+}
+
+type observerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewObserverClient(cc grpc.ClientConnInterface) ObserverClient {
+	return &observerClient{cc}
+}
+
+func (c *observerClient) GetFlows(ctx context.Context, in *GetFlowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetFlowsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Observer_ServiceDesc.Streams[0], Observer_GetFlows_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetFlowsRequest, GetFlowsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Observer_GetFlowsClient = grpc.ServerStreamingClient[GetFlowsResponse]
+
+// ObserverServer is the server API for Observer service.
+// All implementations should embed UnimplementedObserverServer
+// for forward compatibility.
+type ObserverServer interface {
+	GetFlows(*GetFlowsRequest, grpc.ServerStreamingServer[GetFlowsResponse]) error
+}
+
+// UnimplementedObserverServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedObserverServer struct{}
+
+func (UnimplementedObserverServer) GetFlows(*GetFlowsRequest, grpc.ServerStreamingServer[GetFlowsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetFlows not implemented")
+}
+func (UnimplementedObserverServer) testEmbeddedByValue() {}
+
+// UnsafeObserverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ObserverServer will
+// result in compilation errors.
+type UnsafeObserverServer interface {
+	mustEmbedUnimplementedObserverServer()
+}
+
+func RegisterObserverServer(s grpc.ServiceRegistrar, srv ObserverServer) {
+	// If the following call pancis, it indicates UnimplementedObserverServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Observer_ServiceDesc, srv)
+}
+
+func _Observer_GetFlows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFlowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObserverServer).GetFlows(m, &grpc.GenericServerStream[GetFlowsRequest, GetFlowsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Observer_GetFlowsServer = grpc.ServerStreamingServer[GetFlowsResponse]
+
+// Observer_ServiceDesc is the grpc.ServiceDesc for Observer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Observer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "observer.Observer",
+	HandlerType: (*ObserverServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetFlows",
+			Handler:       _Observer_GetFlows_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "observer.proto",
+}
@@ -13,4 +13,11 @@ type collectorInterface interface {
 	registerService(server *grpc.Server)
 }
 
+// streamCollectorInterface Interface for collectors that pull telemetry from a remote endpoint
+type streamCollectorInterface interface {
+	collectorInterface
+	startStream()
+	stopStream()
+}
+
 // == //
@@ -6,7 +6,9 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
+	"Agent/config"
 	"Agent/k8s"
 	"Agent/processor"
 	"Agent/types"
@@ -75,6 +77,20 @@ func generateAPILogsFromOtel(logText string) []*protobuf.APILog {
 		path := words[2]
 		protocol := words[3]
 		resCode, _ := strconv.ParseInt(words[4], 10, 64)
+		duration, _ := strconv.ParseInt(words[11], 10, 64) // milliseconds
+		requestID := strings.Trim(words[15], `"`)
+		host := strings.Trim(words[16], `"`)
+		side := reportingSide(words[18])
+
+		if requestID == "-" {
+			requestID = ""
+		}
+
+		// The default format starts with "[%START_TIME%]" in RFC 3339 with milliseconds
+		startTime := int64(0)
+		if t, err := time.Parse(time.RFC3339Nano, strings.Trim(timeStamp, "[]")); err == nil {
+			startTime = t.UnixNano()
+		}
 
 		srcInform := words[21]
 
@@ -86,6 +102,11 @@ func generateAPILogsFromOtel(logText string) []*protobuf.APILog {
 		}
 		src := k8s.LookupK8sResource(srcIP)
 
+		// External requests entering through an ingress gateway are attributed to the matching route
+		if src.Type == types.K8sResourceTypeUnknown {
+			src = k8s.LookupIngressRoute(host, path)
+		}
+
 		dstInform := words[20]
 
 		// Extract the left and right words based on the colon delimiter (ADDR:PORT)
@@ -96,6 +117,16 @@ func generateAPILogsFromOtel(logText string) []*protobuf.APILog {
 		}
 		dst := k8s.LookupK8sResource(dstIP)
 
+		// Requests relayed by ztunnel or waypoint proxies are attributed to the real workloads
+		if config.GlobalConfig.AmbientMode {
+			forwardedFor := strings.Trim(words[13], `"`)
+			upstreamHost := strings.Trim(words[17], `"`)
+			upstreamCluster := words[18]
+
+			src, srcIP = resolveAmbientSource(src, srcIP, forwardedFor)
+			dst, dstIP, dstPort = resolveAmbientDestination(dst, dstIP, dstPort, upstreamHost, upstreamCluster)
+		}
+
 		// Create APILog
 		apiLog := protobuf.APILog{
 			Id:        0, // @todo zero for now
@@ -107,6 +138,7 @@ func generateAPILogsFromOtel(logText string) []*protobuf.APILog {
 			SrcIP:        srcIP,
 			SrcPort:      srcPort,
 			SrcType:      types.K8sResourceTypeToString(src.Type),
+			SrcZone:      src.Zone,
 
 			DstNamespace: dst.Namespace,
 			DstName:      dst.Name,
@@ -114,11 +146,25 @@ func generateAPILogsFromOtel(logText string) []*protobuf.APILog {
 			DstIP:        dstIP,
 			DstPort:      dstPort,
 			DstType:      types.K8sResourceTypeToString(dst.Type),
+			DstZone:      dst.Zone,
 
 			Protocol:     protocol,
 			Method:       method,
 			Path:         path,
 			ResponseCode: int32(resCode),
+			Host:         host,
+
+			RequestID:  requestID,
+			ReportedBy: side,
+			StartTime:  startTime,
+		}
+
+		// The same request is reported by the sidecars at both ends, Operator pairs the two reports
+		switch side {
+		case "client":
+			apiLog.ClientLatency = duration * int64(time.Millisecond)
+		case "server":
+			apiLog.ServerLatency = duration * int64(time.Millisecond)
 		}
 
 		apiLogs = append(apiLogs, &apiLog)
@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -19,14 +20,76 @@ type AgentConfig struct {
 	OperatorAddr string // IP address to use for Operator gRPC
 	OperatorPort string // Port to use for Operator gRPC
 
+	OperatorTokenFile string // File holding the bearer token presented to Operator (empty: no token)
+	OperatorTLSCA     string // CA for verifying Operator (empty: plaintext)
+	OperatorTLSCert   string // Client certificate presented to Operator (empty: no client certificate)
+	OperatorTLSKey    string // Client key presented to Operator
+
 	ClusterName string // Name of the cluster
 
+	Kubeconfig   string // Path to the kubeconfig file (empty: in-cluster config or default loading rules)
+	KubeContexts string // Comma-separated kubeconfig contexts to watch (empty: current context)
+
+	IstioNamespace      string // Namespace of the Istio control plane
+	IstioRevision       string // Revision of the Istio control plane (empty: default revision)
+	AgentServiceHost    string // Service host that Envoy proxies use to reach Agent
+	TelemetryMode       string // How Envoy proxies are pointed at Agent ("configmap" or "telemetry")
+	TelemetryNamespaces string // Namespaces that get a Telemetry resource (empty: mesh-wide)
+
+	EnvoyNamespaceKeys string // Comma-separated node metadata keys that hold the namespace of an Envoy proxy
+	EnvoyNameKeys      string // Comma-separated node metadata keys that hold the name of an Envoy proxy
+	EnvoyIPKeys        string // Comma-separated node metadata keys that hold the IP addresses of an Envoy proxy
+
+	AmbientMode         bool   // Enable/Disable collecting telemetry from ztunnel and waypoint proxies
+	ZtunnelMetricsPort  string // Port where ztunnel exposes Prometheus metrics
+	ZtunnelScrapePeriod int    // Period (in seconds) for scraping ztunnel metrics
+
+	HubbleEnabled bool   // Enable/Disable collecting flows from Hubble (Cilium)
+	HubbleAddr    string // Address of the Hubble Observer gRPC endpoint (Hubble Relay)
+	HubbleTLS     bool   // Enable/Disable TLS towards Hubble Relay
+	HubbleTLSCA   string // CA for verifying Hubble Relay (empty: system roots)
+	HubbleTLSCert string // Client certificate for Hubble Relay (empty: no client certificate)
+	HubbleTLSKey  string // Client key for Hubble Relay
+	HubbleTLSName string // Server name to verify Hubble Relay against (empty: host of HubbleAddr)
+
+	AuditWebhook      bool   // Enable/Disable receiving API server audit events
+	AuditWebhookPort  string // Port for the audit webhook
+	AuditWebhookCert  string // TLS certificate for the audit webhook (required)
+	AuditWebhookKey   string // TLS key for the audit webhook (required)
+	AuditWebhookCA    string // CA for verifying the client certificate of the API server
+	AuditWebhookToken string // File holding the bearer token that the API server presents
+	AuditVerbs        string // Comma-separated verbs to forward (empty: all verbs)
+	AuditResources    string // Comma-separated resources (or resource/subresource) to forward (empty: all resources)
+
 	PatchingNamespaces           bool // Enable/Disable patching namespaces with 'istio-injection'
 	RestartingPatchedDeployments bool // Enable/Disable restarting deployments after patching
 
+	PatchingIncludeSelector string // Label selector for namespaces to patch (empty: all namespaces)
+	PatchingExcludeSelector string // Label selector for namespaces not to patch
+	PatchingDryRun          bool   // Enable/Disable reporting changes without applying them
+	RestartInterval         int    // Interval (in seconds) between deployment restarts
+	UnpatchingNamespaces    bool   // Enable/Disable reverting patched namespaces on uninstall
+
+	Uninstall bool // Revert the mesh changes made by Agent and exit
+
 	AggregationPeriod int // Period for aggregating metrics
 	CleanUpPeriod     int // Period for cleaning up outdated metrics
 
+	SnapshotPeriod int // Period for resyncing the full cluster snapshot with Operator
+
+	HeartbeatPeriod int // Period (in seconds) for sending heartbeats to Operator
+
+	ClusterNotices bool   // Enable/Disable forwarding Kubernetes Events as cluster notices
+	NoticeTypes    string // Comma-separated Event types to forward (empty: all types)
+	NoticeReasons  string // Comma-separated Event reasons to forward (empty: all reasons)
+
+	LeaderElection          bool   // Enable/Disable electing a leader among Agent replicas
+	LeaderElectionNamespace string // Namespace of the leader Lease (empty: namespace of Agent)
+	LeaderElectionID        string // Name of the leader Lease
+	LeaseDuration           int    // Duration (in seconds) that non-leaders wait before taking over
+	RenewDeadline           int    // Duration (in seconds) that the leader retries renewing before giving up
+	RetryPeriod             int    // Duration (in seconds) between leader election attempts
+
 	Debug bool // Enable/Disable Agent debug mode
 }
 
@@ -38,6 +101,12 @@ func init() {
 	_ = LoadConfig()
 }
 
+// DefaultClusterName is used when no cluster name is given
+const DefaultClusterName = "UnKnown"
+
+// Version of Agent (set at build time with -ldflags "-X Agent/config.Version=...")
+var Version = "dev"
+
 // Config const
 const (
 	CollectorAddr string = "collectorAddr"
@@ -46,14 +115,76 @@ const (
 	OperatorAddr string = "operatorAddr"
 	OperatorPort string = "operatorPort"
 
+	OperatorTokenFile string = "operatorTokenFile"
+	OperatorTLSCA     string = "operatorTLSCA"
+	OperatorTLSCert   string = "operatorTLSCert"
+	OperatorTLSKey    string = "operatorTLSKey"
+
 	ClusterName string = "clusterName"
 
+	Kubeconfig   string = "kubeconfig"
+	KubeContexts string = "kubeContexts"
+
+	IstioNamespace      string = "istioNamespace"
+	IstioRevision       string = "istioRevision"
+	AgentServiceHost    string = "agentServiceHost"
+	TelemetryMode       string = "telemetryMode"
+	TelemetryNamespaces string = "telemetryNamespaces"
+
+	EnvoyNamespaceKeys string = "envoyNamespaceKeys"
+	EnvoyNameKeys      string = "envoyNameKeys"
+	EnvoyIPKeys        string = "envoyIPKeys"
+
+	AmbientMode         string = "ambientMode"
+	ZtunnelMetricsPort  string = "ztunnelMetricsPort"
+	ZtunnelScrapePeriod string = "ztunnelScrapePeriod"
+
+	HubbleEnabled string = "hubbleEnabled"
+	HubbleAddr    string = "hubbleAddr"
+	HubbleTLS     string = "hubbleTLS"
+	HubbleTLSCA   string = "hubbleTLSCA"
+	HubbleTLSCert string = "hubbleTLSCert"
+	HubbleTLSKey  string = "hubbleTLSKey"
+	HubbleTLSName string = "hubbleTLSName"
+
+	AuditWebhook      string = "auditWebhook"
+	AuditWebhookPort  string = "auditWebhookPort"
+	AuditWebhookCert  string = "auditWebhookCert"
+	AuditWebhookKey   string = "auditWebhookKey"
+	AuditWebhookCA    string = "auditWebhookCA"
+	AuditWebhookToken string = "auditWebhookToken"
+	AuditVerbs        string = "auditVerbs"
+	AuditResources    string = "auditResources"
+
 	PatchingNamespaces           string = "patchingNamespaces"
 	RestartingPatchedDeployments string = "restartingPatchedDeployments"
 
+	PatchingIncludeSelector string = "patchingIncludeSelector"
+	PatchingExcludeSelector string = "patchingExcludeSelector"
+	PatchingDryRun          string = "patchingDryRun"
+	RestartInterval         string = "restartInterval"
+	UnpatchingNamespaces    string = "unpatchingNamespaces"
+
+	Uninstall string = "uninstall"
+
 	AggregationPeriod string = "aggregationPeriod"
 	CleanUpPeriod     string = "cleanUpPeriod"
 
+	SnapshotPeriod string = "snapshotPeriod"
+
+	HeartbeatPeriod string = "heartbeatPeriod"
+
+	ClusterNotices string = "clusterNotices"
+	NoticeTypes    string = "noticeTypes"
+	NoticeReasons  string = "noticeReasons"
+
+	LeaderElection          string = "leaderElection"
+	LeaderElectionNamespace string = "leaderElectionNamespace"
+	LeaderElectionID        string = "leaderElectionID"
+	LeaseDuration           string = "leaseDuration"
+	RenewDeadline           string = "renewDeadline"
+	RetryPeriod             string = "retryPeriod"
+
 	Debug string = "debug"
 )
 
@@ -64,14 +195,76 @@ func readCmdLineParams() {
 	operatorAddrStr := flag.String(OperatorAddr, "sentryflow-operator.sentryflow.svc.cluster.local", "Address for Operator gRPC")
 	operatorPortStr := flag.String(OperatorPort, "5317", "Port for Operator gRPC")
 
-	clusterNameStr := flag.String(ClusterName, "UnKnown", "Name of the Kubernetes cluster")
+	operatorTokenFileStr := flag.String(OperatorTokenFile, "", "File holding the bearer token presented to Operator (empty: no token)")
+	operatorTLSCAStr := flag.String(OperatorTLSCA, "", "CA for verifying Operator (empty: plaintext)")
+	operatorTLSCertStr := flag.String(OperatorTLSCert, "", "Client certificate presented to Operator (empty: no client certificate)")
+	operatorTLSKeyStr := flag.String(OperatorTLSKey, "", "Client key presented to Operator")
+
+	clusterNameStr := flag.String(ClusterName, DefaultClusterName, "Name of the Kubernetes cluster")
+
+	kubeconfigStr := flag.String(Kubeconfig, "", "Path to the kubeconfig file for running outside of the cluster")
+	kubeContextsStr := flag.String(KubeContexts, "", "Comma-separated kubeconfig contexts to watch (empty: current context), several rule out the audit webhook, Hubble, patching and uninstalling")
+
+	istioNamespaceStr := flag.String(IstioNamespace, "istio-system", "Namespace of the Istio control plane")
+	istioRevisionStr := flag.String(IstioRevision, "", "Revision of the Istio control plane (empty: default revision)")
+	agentServiceHostStr := flag.String(AgentServiceHost, "sentryflow-agent.sentryflow.svc.cluster.local", "Service host that Envoy proxies use to reach Agent")
+	telemetryModeStr := flag.String(TelemetryMode, "configmap", "How Envoy proxies are pointed at Agent (configmap or telemetry)")
+	telemetryNamespacesStr := flag.String(TelemetryNamespaces, "", "Comma-separated namespaces that get a Telemetry resource (empty: mesh-wide)")
+
+	envoyNamespaceKeysStr := flag.String(EnvoyNamespaceKeys, "NAMESPACE,POD_NAMESPACE", "Comma-separated node metadata keys that hold the namespace of an Envoy proxy")
+	envoyNameKeysStr := flag.String(EnvoyNameKeys, "NAME,POD_NAME", "Comma-separated node metadata keys that hold the name of an Envoy proxy")
+	envoyIPKeysStr := flag.String(EnvoyIPKeys, "INSTANCE_IPS,POD_IP", "Comma-separated node metadata keys that hold the IP addresses of an Envoy proxy")
+
+	ambientModeB := flag.Bool(AmbientMode, false, "Enable collecting telemetry from ztunnel and waypoint proxies (Istio ambient mode)")
+	ztunnelMetricsPortStr := flag.String(ZtunnelMetricsPort, "15020", "Port where ztunnel exposes Prometheus metrics")
+	ztunnelScrapePeriodInt := flag.Int(ZtunnelScrapePeriod, 15, "Period (in seconds) for scraping ztunnel metrics")
+
+	hubbleEnabledB := flag.Bool(HubbleEnabled, false, "Enable collecting flows from Hubble (Cilium)")
+	hubbleAddrStr := flag.String(HubbleAddr, "hubble-relay.kube-system.svc.cluster.local:80", "Address of the Hubble Observer gRPC endpoint")
+	hubbleTLSB := flag.Bool(HubbleTLS, false, "Enable TLS towards Hubble Relay")
+	hubbleTLSCAStr := flag.String(HubbleTLSCA, "", "CA for verifying Hubble Relay (empty: system roots)")
+	hubbleTLSCertStr := flag.String(HubbleTLSCert, "", "Client certificate for Hubble Relay (empty: no client certificate)")
+	hubbleTLSKeyStr := flag.String(HubbleTLSKey, "", "Client key for Hubble Relay")
+	hubbleTLSNameStr := flag.String(HubbleTLSName, "", "Server name to verify Hubble Relay against (empty: host of hubbleAddr)")
+
+	auditWebhookB := flag.Bool(AuditWebhook, false, "Enable receiving API server audit events (audit.k8s.io/v1 EventList)")
+	auditWebhookPortStr := flag.String(AuditWebhookPort, "8443", "Port for the audit webhook")
+	auditWebhookCertStr := flag.String(AuditWebhookCert, "", "TLS certificate for the audit webhook (required)")
+	auditWebhookKeyStr := flag.String(AuditWebhookKey, "", "TLS key for the audit webhook (required)")
+	auditWebhookCAStr := flag.String(AuditWebhookCA, "", "CA for verifying the client certificate of the API server (either this or auditWebhookToken is required)")
+	auditWebhookTokenStr := flag.String(AuditWebhookToken, "", "File holding the bearer token that the API server presents (either this or auditWebhookCA is required)")
+	auditVerbsStr := flag.String(AuditVerbs, "create,update,patch,delete,deletecollection", "Comma-separated verbs to forward (empty: all verbs)")
+	auditResourcesStr := flag.String(AuditResources, "", "Comma-separated resources or resource/subresource pairs to forward, e.g. secrets,pods/exec (empty: all resources)")
 
 	patchingNamespacesB := flag.Bool(PatchingNamespaces, false, "Enable patching 'istio-injection' to all namespaces")
 	restartingPatchedDeploymentsB := flag.Bool(RestartingPatchedDeployments, false, "Enable restarting the deployments in all patched namespaces")
 
+	patchingIncludeSelectorStr := flag.String(PatchingIncludeSelector, "", "Label selector for namespaces to patch (empty: all namespaces)")
+	patchingExcludeSelectorStr := flag.String(PatchingExcludeSelector, "kubernetes.io/metadata.name in (kube-system,kube-public,kube-node-lease,istio-system)", "Label selector for namespaces not to patch")
+	patchingDryRunB := flag.Bool(PatchingDryRun, false, "Report namespaces and deployments that would be changed without changing them")
+	restartIntervalInt := flag.Int(RestartInterval, 5, "Interval (in seconds) between deployment restarts")
+	unpatchingNamespacesB := flag.Bool(UnpatchingNamespaces, false, "Enable reverting the patched namespaces on uninstall")
+
+	uninstallB := flag.Bool(Uninstall, false, "Revert the Istio ConfigMap, Telemetry resources and namespace labels changed by Agent, and exit")
+
 	aggregationPeriodInt := flag.Int(AggregationPeriod, 1, "Period for aggregating metrics")
 	cleanUpPeriodInt := flag.Int(CleanUpPeriod, 5, "Period for cleanning up outdated metrics")
 
+	snapshotPeriodInt := flag.Int(SnapshotPeriod, 300, "Period (in seconds) for resyncing the cluster snapshot, 0 to disable")
+
+	heartbeatPeriodInt := flag.Int(HeartbeatPeriod, 15, "Period (in seconds) for sending heartbeats to Operator")
+
+	clusterNoticesB := flag.Bool(ClusterNotices, true, "Enable forwarding Kubernetes Events as cluster notices")
+	noticeTypesStr := flag.String(NoticeTypes, "Warning", "Comma-separated Event types to forward (empty: all types)")
+	noticeReasonsStr := flag.String(NoticeReasons, "", "Comma-separated Event reasons to forward, e.g. OOMKilling,BackOff,FailedScheduling,Unhealthy (empty: all reasons)")
+
+	leaderElectionB := flag.Bool(LeaderElection, false, "Enable leader election so that only one Agent replica patches the mesh and uploads cluster events")
+	leaderElectionNamespaceStr := flag.String(LeaderElectionNamespace, "", "Namespace of the leader Lease (empty: namespace of Agent)")
+	leaderElectionIDStr := flag.String(LeaderElectionID, "sentryflow-agent", "Name of the leader Lease")
+	leaseDurationInt := flag.Int(LeaseDuration, 15, "Duration (in seconds) that non-leaders wait before taking over")
+	renewDeadlineInt := flag.Int(RenewDeadline, 10, "Duration (in seconds) that the leader retries renewing before giving up")
+	retryPeriodInt := flag.Int(RetryPeriod, 2, "Duration (in seconds) between leader election attempts")
+
 	configDebugB := flag.Bool(Debug, false, "Enable debugging mode")
 
 	var flags []string
@@ -89,14 +282,76 @@ func readCmdLineParams() {
 	viper.SetDefault(OperatorAddr, *operatorAddrStr)
 	viper.SetDefault(OperatorPort, *operatorPortStr)
 
+	viper.SetDefault(OperatorTokenFile, *operatorTokenFileStr)
+	viper.SetDefault(OperatorTLSCA, *operatorTLSCAStr)
+	viper.SetDefault(OperatorTLSCert, *operatorTLSCertStr)
+	viper.SetDefault(OperatorTLSKey, *operatorTLSKeyStr)
+
 	viper.SetDefault(ClusterName, *clusterNameStr)
 
+	viper.SetDefault(Kubeconfig, *kubeconfigStr)
+	viper.SetDefault(KubeContexts, *kubeContextsStr)
+
+	viper.SetDefault(IstioNamespace, *istioNamespaceStr)
+	viper.SetDefault(IstioRevision, *istioRevisionStr)
+	viper.SetDefault(AgentServiceHost, *agentServiceHostStr)
+	viper.SetDefault(TelemetryMode, *telemetryModeStr)
+	viper.SetDefault(TelemetryNamespaces, *telemetryNamespacesStr)
+
+	viper.SetDefault(EnvoyNamespaceKeys, *envoyNamespaceKeysStr)
+	viper.SetDefault(EnvoyNameKeys, *envoyNameKeysStr)
+	viper.SetDefault(EnvoyIPKeys, *envoyIPKeysStr)
+
+	viper.SetDefault(AmbientMode, *ambientModeB)
+	viper.SetDefault(ZtunnelMetricsPort, *ztunnelMetricsPortStr)
+	viper.SetDefault(ZtunnelScrapePeriod, *ztunnelScrapePeriodInt)
+
+	viper.SetDefault(HubbleEnabled, *hubbleEnabledB)
+	viper.SetDefault(HubbleAddr, *hubbleAddrStr)
+	viper.SetDefault(HubbleTLS, *hubbleTLSB)
+	viper.SetDefault(HubbleTLSCA, *hubbleTLSCAStr)
+	viper.SetDefault(HubbleTLSCert, *hubbleTLSCertStr)
+	viper.SetDefault(HubbleTLSKey, *hubbleTLSKeyStr)
+	viper.SetDefault(HubbleTLSName, *hubbleTLSNameStr)
+
+	viper.SetDefault(AuditWebhook, *auditWebhookB)
+	viper.SetDefault(AuditWebhookPort, *auditWebhookPortStr)
+	viper.SetDefault(AuditWebhookCert, *auditWebhookCertStr)
+	viper.SetDefault(AuditWebhookKey, *auditWebhookKeyStr)
+	viper.SetDefault(AuditWebhookCA, *auditWebhookCAStr)
+	viper.SetDefault(AuditWebhookToken, *auditWebhookTokenStr)
+	viper.SetDefault(AuditVerbs, *auditVerbsStr)
+	viper.SetDefault(AuditResources, *auditResourcesStr)
+
 	viper.SetDefault(PatchingNamespaces, *patchingNamespacesB)
 	viper.SetDefault(RestartingPatchedDeployments, *restartingPatchedDeploymentsB)
 
+	viper.SetDefault(PatchingIncludeSelector, *patchingIncludeSelectorStr)
+	viper.SetDefault(PatchingExcludeSelector, *patchingExcludeSelectorStr)
+	viper.SetDefault(PatchingDryRun, *patchingDryRunB)
+	viper.SetDefault(RestartInterval, *restartIntervalInt)
+	viper.SetDefault(UnpatchingNamespaces, *unpatchingNamespacesB)
+
+	viper.SetDefault(Uninstall, *uninstallB)
+
 	viper.SetDefault(AggregationPeriod, *aggregationPeriodInt)
 	viper.SetDefault(CleanUpPeriod, *cleanUpPeriodInt)
 
+	viper.SetDefault(SnapshotPeriod, *snapshotPeriodInt)
+
+	viper.SetDefault(HeartbeatPeriod, *heartbeatPeriodInt)
+
+	viper.SetDefault(ClusterNotices, *clusterNoticesB)
+	viper.SetDefault(NoticeTypes, *noticeTypesStr)
+	viper.SetDefault(NoticeReasons, *noticeReasonsStr)
+
+	viper.SetDefault(LeaderElection, *leaderElectionB)
+	viper.SetDefault(LeaderElectionNamespace, *leaderElectionNamespaceStr)
+	viper.SetDefault(LeaderElectionID, *leaderElectionIDStr)
+	viper.SetDefault(LeaseDuration, *leaseDurationInt)
+	viper.SetDefault(RenewDeadline, *renewDeadlineInt)
+	viper.SetDefault(RetryPeriod, *retryPeriodInt)
+
 	viper.SetDefault(Debug, *configDebugB)
 }
 
@@ -114,17 +369,92 @@ func LoadConfig() error {
 	GlobalConfig.OperatorAddr = viper.GetString(OperatorAddr)
 	GlobalConfig.OperatorPort = viper.GetString(OperatorPort)
 
+	GlobalConfig.OperatorTokenFile = viper.GetString(OperatorTokenFile)
+	GlobalConfig.OperatorTLSCA = viper.GetString(OperatorTLSCA)
+	GlobalConfig.OperatorTLSCert = viper.GetString(OperatorTLSCert)
+	GlobalConfig.OperatorTLSKey = viper.GetString(OperatorTLSKey)
+
 	GlobalConfig.ClusterName = viper.GetString(ClusterName)
 
+	GlobalConfig.Kubeconfig = viper.GetString(Kubeconfig)
+	GlobalConfig.KubeContexts = viper.GetString(KubeContexts)
+
+	GlobalConfig.IstioNamespace = viper.GetString(IstioNamespace)
+	GlobalConfig.IstioRevision = viper.GetString(IstioRevision)
+	GlobalConfig.AgentServiceHost = viper.GetString(AgentServiceHost)
+	GlobalConfig.TelemetryMode = viper.GetString(TelemetryMode)
+	GlobalConfig.TelemetryNamespaces = viper.GetString(TelemetryNamespaces)
+
+	GlobalConfig.EnvoyNamespaceKeys = viper.GetString(EnvoyNamespaceKeys)
+	GlobalConfig.EnvoyNameKeys = viper.GetString(EnvoyNameKeys)
+	GlobalConfig.EnvoyIPKeys = viper.GetString(EnvoyIPKeys)
+
+	GlobalConfig.AmbientMode = viper.GetBool(AmbientMode)
+	GlobalConfig.ZtunnelMetricsPort = viper.GetString(ZtunnelMetricsPort)
+	GlobalConfig.ZtunnelScrapePeriod = viper.GetInt(ZtunnelScrapePeriod)
+
+	GlobalConfig.HubbleEnabled = viper.GetBool(HubbleEnabled)
+	GlobalConfig.HubbleAddr = viper.GetString(HubbleAddr)
+	GlobalConfig.HubbleTLS = viper.GetBool(HubbleTLS)
+	GlobalConfig.HubbleTLSCA = viper.GetString(HubbleTLSCA)
+	GlobalConfig.HubbleTLSCert = viper.GetString(HubbleTLSCert)
+	GlobalConfig.HubbleTLSKey = viper.GetString(HubbleTLSKey)
+	GlobalConfig.HubbleTLSName = viper.GetString(HubbleTLSName)
+
+	GlobalConfig.AuditWebhook = viper.GetBool(AuditWebhook)
+	GlobalConfig.AuditWebhookPort = viper.GetString(AuditWebhookPort)
+	GlobalConfig.AuditWebhookCert = viper.GetString(AuditWebhookCert)
+	GlobalConfig.AuditWebhookKey = viper.GetString(AuditWebhookKey)
+	GlobalConfig.AuditWebhookCA = viper.GetString(AuditWebhookCA)
+	GlobalConfig.AuditWebhookToken = viper.GetString(AuditWebhookToken)
+	GlobalConfig.AuditVerbs = viper.GetString(AuditVerbs)
+	GlobalConfig.AuditResources = viper.GetString(AuditResources)
+
 	GlobalConfig.PatchingNamespaces = viper.GetBool(PatchingNamespaces)
 	GlobalConfig.RestartingPatchedDeployments = viper.GetBool(RestartingPatchedDeployments)
 
+	GlobalConfig.PatchingIncludeSelector = viper.GetString(PatchingIncludeSelector)
+	GlobalConfig.PatchingExcludeSelector = viper.GetString(PatchingExcludeSelector)
+	GlobalConfig.PatchingDryRun = viper.GetBool(PatchingDryRun)
+	GlobalConfig.RestartInterval = viper.GetInt(RestartInterval)
+	GlobalConfig.UnpatchingNamespaces = viper.GetBool(UnpatchingNamespaces)
+
+	GlobalConfig.Uninstall = viper.GetBool(Uninstall)
+
 	GlobalConfig.AggregationPeriod = viper.GetInt(AggregationPeriod)
 	GlobalConfig.CleanUpPeriod = viper.GetInt(CleanUpPeriod)
 
+	GlobalConfig.SnapshotPeriod = viper.GetInt(SnapshotPeriod)
+
+	GlobalConfig.HeartbeatPeriod = viper.GetInt(HeartbeatPeriod)
+
+	GlobalConfig.ClusterNotices = viper.GetBool(ClusterNotices)
+	GlobalConfig.NoticeTypes = viper.GetString(NoticeTypes)
+	GlobalConfig.NoticeReasons = viper.GetString(NoticeReasons)
+
+	GlobalConfig.LeaderElection = viper.GetBool(LeaderElection)
+	GlobalConfig.LeaderElectionNamespace = viper.GetString(LeaderElectionNamespace)
+	GlobalConfig.LeaderElectionID = viper.GetString(LeaderElectionID)
+	GlobalConfig.LeaseDuration = viper.GetInt(LeaseDuration)
+	GlobalConfig.RenewDeadline = viper.GetInt(RenewDeadline)
+	GlobalConfig.RetryPeriod = viper.GetInt(RetryPeriod)
+
 	GlobalConfig.Debug = viper.GetBool(Debug)
 
 	log.Printf("Configuration [%+v]", GlobalConfig)
 
 	return nil
 }
+
+// AgentID Function that returns the identity of this Agent replica
+func AgentID() string {
+	if name := os.Getenv("POD_NAME"); name != "" {
+		return name
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "sentryflow-agent"
+	}
+	return hostname
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"sync"
+	"sync/atomic"
 	"syscall"
 
 	"Agent/collector"
@@ -29,6 +30,8 @@ func init() {
 // AgentService Structure
 type AgentService struct {
 	waitGroup *sync.WaitGroup
+
+	leading atomic.Bool // whether this replica owns mesh patching and cluster events
 }
 
 // NewAgent Function
@@ -40,15 +43,15 @@ func NewAgent() *AgentService {
 
 // DestroyAgent Function
 func (sf *AgentService) DestroyAgent() {
-	close(StopChan)
-
-	// Remove Agent collector config from Kubernetes
-	if k8s.UnpatchIstioConfigMap() {
-		log.Print("[Agent] Unpatched Istio ConfigMap")
-	} else {
-		log.Print("[Agent] Failed to unpatch Istio ConfigMap")
+	// The mesh stays patched across restarts and leader handovers, so the leader only reverts it
+	// when SentryFlow is being removed (while it still holds the Lease, so no other replica patches again)
+	if sf.leading.Load() && !k8s.MultipleClusters() && k8s.AgentDeploymentRemoved() {
+		log.Print("[Agent] The Deployment of Agent is being removed, reverting the mesh")
+		sf.unpatchMesh()
 	}
 
+	close(StopChan)
+
 	// Stop collector
 	if collector.StopCollector() {
 		log.Print("[Agent] Stopped Collectors")
@@ -77,6 +80,88 @@ func (sf *AgentService) DestroyAgent() {
 	log.Print("[Agent] Terminated Agent")
 }
 
+// unpatchMesh Function that reverts what Agent changed in the mesh
+// (run when SentryFlow is uninstalled, never when a replica merely restarts)
+func (sf *AgentService) unpatchMesh() {
+	// Remove Telemetry resources created by Agent
+	if config.GlobalConfig.TelemetryMode == k8s.TelemetryModeTelemetry {
+		if k8s.UnpatchIstioTelemetry() {
+			log.Print("[Agent] Unpatched Istio Telemetry")
+		} else {
+			log.Print("[Agent] Failed to unpatch Istio Telemetry")
+		}
+	}
+
+	// Remove Agent collector config from Kubernetes
+	if k8s.UnpatchIstioConfigMap() {
+		log.Print("[Agent] Unpatched Istio ConfigMap")
+	} else {
+		log.Print("[Agent] Failed to unpatch Istio ConfigMap")
+	}
+
+	// Revert the labels that Agent added to namespaces
+	if config.GlobalConfig.UnpatchingNamespaces {
+		if k8s.UnpatchNamespaces() {
+			log.Print("[Agent] Unpatched Namespaces")
+		} else {
+			log.Print("[Agent] Failed to unpatch Namespaces")
+		}
+	}
+}
+
+// startLeading Function that takes over cluster events and mesh patching
+func (sf *AgentService) startLeading(stopChan <-chan struct{}) bool {
+	sf.leading.Store(true)
+
+	// Pull the telemetry of the whole cluster
+	collector.StartLeaderCollectors()
+
+	// Upload cluster events, and a full snapshot for whatever happened before
+	uploader.UplH.EnableClusterEvents(true)
+	k8s.RunClusterSnapshots(stopChan, sf.waitGroup)
+
+	// Meshes of other clusters reach Agent through their own setup, so none is patched with several clusters
+	if k8s.MultipleClusters() {
+		log.Print("[Agent] Watching several clusters, leaving their meshes unpatched")
+		return true
+	}
+
+	// Patch Istio ConfigMap
+	if !k8s.PatchIstioConfigMap() {
+		return false
+	}
+
+	// Enable Agent as access log provider through the Telemetry API
+	if config.GlobalConfig.TelemetryMode == k8s.TelemetryModeTelemetry {
+		if !k8s.PatchIstioTelemetry() {
+			return false
+		}
+	}
+
+	// Patch Namespaces
+	if config.GlobalConfig.PatchingNamespaces {
+		if !k8s.PatchNamespaces() {
+			return false
+		}
+	}
+
+	// Patch Deployments
+	if config.GlobalConfig.RestartingPatchedDeployments {
+		if !k8s.RestartDeployments() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// stopLeading Function that hands cluster events and mesh patching over to the next leader
+func (sf *AgentService) stopLeading() {
+	sf.leading.Store(false)
+	uploader.UplH.EnableClusterEvents(false)
+	collector.StopLeaderCollectors()
+}
+
 // == //
 
 // GetOSSigChannel Function
@@ -101,6 +186,22 @@ func Agent() {
 
 	log.Print("[Agent] Initializing Agent")
 
+	// Features tied to the cluster Agent runs in cannot tell several clusters apart
+	if err := k8s.CheckClusterFeatures(); err != nil {
+		log.Printf("[Agent] Invalid configuration: %v", err)
+		return
+	}
+
+	// Uninstall reverts the mesh without starting anything else
+	if config.GlobalConfig.Uninstall {
+		if !k8s.InitK8sClient() {
+			return
+		}
+		sf.unpatchMesh()
+		log.Print("[Agent] Reverted the mesh changes made by Agent")
+		return
+	}
+
 	// == //
 
 	// Start collector
@@ -129,29 +230,26 @@ func Agent() {
 		return
 	}
 
-	// Start Kubernetes informers
-	k8s.RunInformers(StopChan, sf.waitGroup)
+	// Register with Operator for the clusters found in the kubeconfig
+	uploader.SetWatchedClusters(k8s.ClusterNames())
 
-	// Patch Istio ConfigMap
-	if !k8s.PatchIstioConfigMap() {
-		sf.DestroyAgent()
-		return
-	}
+	// Start Kubernetes informers (every replica resolves the workloads of its telemetry)
+	k8s.RunInformers(StopChan, sf.waitGroup)
 
-	// Patch Namespaces
-	if config.GlobalConfig.PatchingNamespaces {
-		if !k8s.PatchNamespaces() {
-			sf.DestroyAgent()
-			return
+	if config.GlobalConfig.LeaderElection {
+		// Only the leader uploads cluster events and patches the mesh
+		onStarted := func(leaderChan <-chan struct{}) {
+			if !sf.startLeading(leaderChan) {
+				log.Print("[Agent] Failed to take over the leader duties")
+			}
 		}
-	}
-
-	// Patch Deployments
-	if config.GlobalConfig.RestartingPatchedDeployments {
-		if !k8s.RestartDeployments() {
+		if !k8s.RunLeaderElection(StopChan, sf.waitGroup, onStarted, sf.stopLeading) {
 			sf.DestroyAgent()
 			return
 		}
+	} else if !sf.startLeading(StopChan) {
+		sf.DestroyAgent()
+		return
 	}
 
 	log.Print("[Agent] Initialization is completed")
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"fmt"
+
+	"Agent/types"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// == //
+
+// ListZtunnelAddresses Function that returns the IP addresses of the running ztunnel pods
+func ListZtunnelAddresses() map[string]string {
+	addrs := make(map[string]string) // key: ztunnel IP, value: cluster
+
+	for _, k8s := range K8sHandlers {
+		store, ok := k8s.stores["pods"]
+		if !ok {
+			continue
+		}
+
+		for _, obj := range store.List() {
+			pod, ok := obj.(*corev1.Pod)
+			if !ok || pod.Status.PodIP == "" || pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+			if pod.Labels[types.LabelApp] == types.ZtunnelAppName {
+				addrs[pod.Status.PodIP] = k8s.clusterName
+			}
+		}
+	}
+
+	return addrs
+}
+
+// LookupServiceByName Function that returns a service by its namespace and name
+func LookupServiceByName(namespace, name string) types.K8sResource {
+	ret := types.K8sResource{
+		Cluster:   "Unknown",
+		Namespace: "Unknown",
+		Name:      "Unknown",
+		Labels:    make(map[string]string),
+		Type:      types.K8sResourceTypeUnknown,
+	}
+
+	for _, k8s := range K8sHandlers {
+		store, ok := k8s.stores["services"]
+		if !ok {
+			continue
+		}
+
+		obj, found, err := store.GetByKey(fmt.Sprintf("%s/%s", namespace, name))
+		if err != nil || !found {
+			continue
+		}
+
+		if svc, ok := obj.(*corev1.Service); ok {
+			ret.Cluster = k8s.clusterName
+			ret.Namespace = svc.Namespace
+			ret.Name = svc.Name
+			ret.Labels = svc.Labels
+			ret.Type = types.K8sResourceTypeService
+			break
+		}
+	}
+
+	return ret
+}
+
+// LookupWorkload Function that returns the pod or service of an IP address, ignoring ambient mesh proxies
+func LookupWorkload(ipAddr string) (types.K8sResource, bool) {
+	res := LookupK8sResource(ipAddr)
+	if res.Type == types.K8sResourceTypePod && types.IsAmbientProxy(res.Labels) {
+		return res, false
+	}
+	return res, res.Type != types.K8sResourceTypeUnknown
+}
+
+// == //
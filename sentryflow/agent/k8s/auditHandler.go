@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	"Agent/types"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// == //
+
+// Identity of service accounts in audit events
+const (
+	serviceAccountUsernamePrefix = "system:serviceaccount:"
+	serviceAccountPodNameExtra   = "authentication.kubernetes.io/pod-name" // set for pod-bound tokens
+)
+
+// ParseServiceAccountUsername Function that returns the namespace and name of a service account user
+func ParseServiceAccountUsername(username string) (string, string, bool) {
+	if !strings.HasPrefix(username, serviceAccountUsernamePrefix) {
+		return "", "", false
+	}
+
+	namespace, name, found := strings.Cut(strings.TrimPrefix(username, serviceAccountUsernamePrefix), ":")
+	if !found || namespace == "" || name == "" {
+		return "", "", false
+	}
+	return namespace, name, true
+}
+
+// podServiceAccount Function that returns the service account that a pod runs as
+func podServiceAccount(pod *corev1.Pod) string {
+	if pod.Spec.ServiceAccountName == "" {
+		return "default"
+	}
+	return pod.Spec.ServiceAccountName
+}
+
+// podResource Function that converts a pod into a K8sResource
+func (k8s *KubernetesHandler) podResource(pod *corev1.Pod) types.K8sResource {
+	return types.K8sResource{
+		Cluster:   k8s.clusterName,
+		Type:      types.K8sResourceTypePod,
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		Labels:    pod.Labels,
+		Zone:      k8s.lookupNodeZone(pod.Spec.NodeName),
+	}
+}
+
+// LookupServiceAccountWorkload Function that returns the pod acting as a service account in an audit event
+// (the pod bound to the token first, then the pod at the source IP, then the only pod running as the account)
+func LookupServiceAccountWorkload(user types.AuditUser, sourceIPs []string) (types.K8sResource, bool) {
+	namespace, serviceAccount, ok := ParseServiceAccountUsername(user.Username)
+	if !ok {
+		return types.K8sResource{}, false
+	}
+
+	matches := func(pod *corev1.Pod) bool {
+		return pod.Namespace == namespace && podServiceAccount(pod) == serviceAccount
+	}
+
+	if podNames := user.Extra[serviceAccountPodNameExtra]; len(podNames) > 0 {
+		for _, k8s := range K8sHandlers {
+			store, ok := k8s.stores["pods"]
+			if !ok {
+				continue
+			}
+
+			obj, found, err := store.GetByKey(fmt.Sprintf("%s/%s", namespace, podNames[0]))
+			if err != nil || !found {
+				continue
+			}
+
+			if pod, ok := obj.(*corev1.Pod); ok && matches(pod) {
+				return k8s.podResource(pod), true
+			}
+		}
+	}
+
+	for _, ipAddr := range sourceIPs {
+		for _, k8s := range K8sHandlers {
+			if pod, ok := k8s.lookupIPAddress(ipAddr).(*corev1.Pod); ok && matches(pod) {
+				return k8s.podResource(pod), true
+			}
+		}
+	}
+
+	// Accounts shared by several pods (e.g., "default") cannot be pinned on one of them
+	var only types.K8sResource
+	running := 0
+
+	for _, k8s := range K8sHandlers {
+		store, ok := k8s.stores["pods"]
+		if !ok {
+			continue
+		}
+
+		for _, obj := range store.List() {
+			if pod, ok := obj.(*corev1.Pod); ok && pod.Status.Phase == corev1.PodRunning && matches(pod) {
+				if running++; running > 1 {
+					return types.K8sResource{}, false
+				}
+				only = k8s.podResource(pod)
+			}
+		}
+	}
+
+	return only, running == 1
+}
+
+// == //
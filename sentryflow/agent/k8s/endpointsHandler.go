@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+
+	"Agent/types"
+	"Agent/uploader"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+)
+
+// == //
+
+// initEndpointSliceWatchers Function that initializes a watcher for EndpointSlices
+func (k8s *KubernetesHandler) initEndpointSliceWatchers() {
+	k8s.watchers["endpointslices"] = cache.NewListWatchFromClient(
+		k8s.clientSet.DiscoveryV1().RESTClient(),
+		"endpointslices",
+		corev1.NamespaceAll,
+		fields.Everything(),
+	)
+}
+
+// initEndpointSliceInformers Function that initializes an informer for EndpointSlices
+func (k8s *KubernetesHandler) initEndpointSliceInformers() {
+	if k8s.watchers["endpointslices"] == nil {
+		return
+	}
+
+	_, epsController := cache.NewInformerWithOptions(
+		cache.InformerOptions{
+			ListerWatcher: k8s.watchers["endpointslices"],
+			ObjectType:    &discoveryv1.EndpointSlice{},
+			ResyncPeriod:  0,
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc: func(obj interface{}) {
+					eps := obj.(*discoveryv1.EndpointSlice)
+					k8s.updateEndpointSlice(eps, false)
+				},
+				UpdateFunc: func(oldObj, newObj interface{}) {
+					eps := newObj.(*discoveryv1.EndpointSlice)
+					k8s.updateEndpointSlice(eps, false)
+				},
+				DeleteFunc: func(obj interface{}) {
+					eps, ok := obj.(*discoveryv1.EndpointSlice)
+					if !ok {
+						tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+						if !ok {
+							return
+						}
+						if eps, ok = tombstone.Obj.(*discoveryv1.EndpointSlice); !ok {
+							return
+						}
+					}
+					k8s.updateEndpointSlice(eps, true)
+				},
+			},
+		},
+	)
+	k8s.informers["endpointslices"] = epsController
+}
+
+// updateEndpointSlice Function that stores an EndpointSlice and uploads the membership of its service
+func (k8s *KubernetesHandler) updateEndpointSlice(eps *discoveryv1.EndpointSlice, deleted bool) {
+	svcName := eps.Labels[discoveryv1.LabelServiceName]
+	if svcName == "" {
+		return // not managed for a service
+	}
+
+	sliceKey := fmt.Sprintf("%s/%s", eps.Namespace, eps.Name)
+	svcKey := fmt.Sprintf("%s/%s", eps.Namespace, svcName)
+
+	k8s.endpointsLock.Lock()
+
+	if deleted {
+		delete(k8s.endpointSliceMap, sliceKey)
+	} else {
+		k8s.endpointSliceMap[sliceKey] = eps
+	}
+
+	prev, existed := k8s.serviceEndpointsMap[svcKey]
+	cur := k8s.buildServiceEndpoints(eps.Namespace, svcName)
+
+	action := ""
+	switch {
+	case cur == nil && existed:
+		delete(k8s.serviceEndpointsMap, svcKey)
+		action = "DELETE"
+		cur = prev
+	case cur != nil && !existed:
+		k8s.serviceEndpointsMap[svcKey] = cur
+		action = "ADD"
+	case cur != nil && !reflect.DeepEqual(prev, cur):
+		k8s.serviceEndpointsMap[svcKey] = cur
+		action = "UPDATE"
+	}
+
+	k8s.endpointsLock.Unlock()
+
+	if action == "" {
+		return
+	}
+
+	log.Printf("[Informer:EndpointSlice] %s ServiceEndpoints %s (ready=%d, notReady=%d)",
+		action, svcKey, cur.ReadyCount, cur.NotReadyCount)
+	go uploader.UplH.UploadClusterEvent(k8s.clusterName, "ServiceEndpoints", action, cur)
+}
+
+// buildServiceEndpoints Function that merges all slices of a service (endpointsLock must be held)
+func (k8s *KubernetesHandler) buildServiceEndpoints(namespace, svcName string) *types.ServiceEndpoints {
+	found := false
+	se := &types.ServiceEndpoints{
+		Namespace: namespace,
+		Name:      svcName,
+		Endpoints: []types.EndpointRef{},
+	}
+
+	for _, eps := range k8s.endpointSliceMap {
+		if eps.Namespace != namespace || eps.Labels[discoveryv1.LabelServiceName] != svcName {
+			continue
+		}
+		found = true
+
+		for _, ep := range eps.Endpoints {
+			// nil means unknown, which consumers should interpret as ready
+			ready := ep.Conditions.Ready == nil || *ep.Conditions.Ready
+
+			ref := types.EndpointRef{Ready: ready}
+			if ep.TargetRef != nil && ep.TargetRef.Kind == "Pod" {
+				ref.PodNamespace = ep.TargetRef.Namespace
+				ref.PodName = ep.TargetRef.Name
+			}
+			if ep.NodeName != nil {
+				ref.NodeName = *ep.NodeName
+			}
+			if ep.Zone != nil {
+				ref.Zone = *ep.Zone
+			}
+
+			for _, addr := range ep.Addresses {
+				ref.IP = addr
+				se.Endpoints = append(se.Endpoints, ref)
+				if ready {
+					se.ReadyCount++
+				} else {
+					se.NotReadyCount++
+				}
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	sort.Slice(se.Endpoints, func(i, j int) bool {
+		return se.Endpoints[i].IP < se.Endpoints[j].IP
+	})
+
+	return se
+}
+
+// == //
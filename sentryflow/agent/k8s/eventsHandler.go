@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"Agent/config"
+	"Agent/uploader"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+)
+
+// == //
+
+// noticeFilter Function that returns the set of values configured in a comma-separated filter
+func noticeFilter(filter string) map[string]bool {
+	ret := make(map[string]bool)
+	for _, value := range strings.Split(filter, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			ret[value] = true
+		}
+	}
+	return ret
+}
+
+// initEventWatchers Function that initializes a watcher for Events
+// (events.k8s.io/v1 and core/v1 serve the same objects, so core/v1 is only watched
+// when the cluster does not serve events.k8s.io/v1)
+func (k8s *KubernetesHandler) initEventWatchers() {
+	if !config.GlobalConfig.ClusterNotices {
+		return
+	}
+
+	k8s.noticesSince = time.Now()
+
+	// Narrow the watch on the API server side when a single type is wanted
+	selector := fields.Everything()
+	if types := noticeFilter(config.GlobalConfig.NoticeTypes); len(types) == 1 {
+		for eventType := range types {
+			selector = fields.OneTermEqualSelector("type", eventType)
+		}
+	}
+
+	if _, err := k8s.clientSet.Discovery().ServerResourcesForGroupVersion(eventsv1.SchemeGroupVersion.String()); err == nil {
+		k8s.watchers["events.k8s.io"] = cache.NewListWatchFromClient(
+			k8s.clientSet.EventsV1().RESTClient(),
+			"events",
+			corev1.NamespaceAll,
+			selector,
+		)
+		return
+	}
+
+	log.Printf("[Informer:Event] %s is not served in cluster %s, watching core/v1 Events", eventsv1.SchemeGroupVersion.String(), k8s.clusterName)
+
+	k8s.watchers["events"] = cache.NewListWatchFromClient(
+		k8s.clientSet.CoreV1().RESTClient(),
+		"events",
+		corev1.NamespaceAll,
+		selector,
+	)
+}
+
+// initEventInformers Function that initializes an informer for Events
+func (k8s *KubernetesHandler) initEventInformers() {
+	if k8s.watchers["events.k8s.io"] != nil {
+		_, eventController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["events.k8s.io"],
+				ObjectType:    &eventsv1.Event{},
+				ResyncPeriod:  0,
+				Handler: cache.ResourceEventHandlerFuncs{
+					AddFunc: func(obj interface{}) {
+						event := obj.(*eventsv1.Event)
+						k8s.forwardNotice(convertEventToNotice(k8s.clusterName, event))
+					},
+					UpdateFunc: func(oldObj, newObj interface{}) {
+						event := newObj.(*eventsv1.Event)
+						k8s.forwardNotice(convertEventToNotice(k8s.clusterName, event))
+					},
+				},
+			},
+		)
+		k8s.informers["events.k8s.io"] = eventController
+	}
+
+	if k8s.watchers["events"] != nil {
+		_, eventController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["events"],
+				ObjectType:    &corev1.Event{},
+				ResyncPeriod:  0,
+				Handler: cache.ResourceEventHandlerFuncs{
+					AddFunc: func(obj interface{}) {
+						event := obj.(*corev1.Event)
+						k8s.forwardNotice(convertCoreEventToNotice(k8s.clusterName, event))
+					},
+					UpdateFunc: func(oldObj, newObj interface{}) {
+						event := newObj.(*corev1.Event)
+						k8s.forwardNotice(convertCoreEventToNotice(k8s.clusterName, event))
+					},
+				},
+			},
+		)
+		k8s.informers["events"] = eventController
+	}
+}
+
+// forwardNotice Function that uploads a notice if it passes the configured filters
+func (k8s *KubernetesHandler) forwardNotice(notice *protobuf.ClusterNotice, lastSeen time.Time) {
+	// The initial list replays old Events, only the ones seen since Agent started matter
+	if lastSeen.Before(k8s.noticesSince) {
+		return
+	}
+
+	if types := noticeFilter(config.GlobalConfig.NoticeTypes); len(types) > 0 && !types[notice.Type] {
+		return
+	}
+
+	if reasons := noticeFilter(config.GlobalConfig.NoticeReasons); len(reasons) > 0 && !reasons[notice.Reason] {
+		return
+	}
+
+	log.Printf("[Informer:Event] %s %s %s/%s/%s: %s", notice.Type, notice.Reason, notice.Kind, notice.Namespace, notice.Name, notice.Message)
+	go uploader.UplH.UploadClusterNotice(notice)
+}
+
+// == //
+
+// convertEventToNotice Function that converts an events.k8s.io/v1 Event
+func convertEventToNotice(cluster string, event *eventsv1.Event) (*protobuf.ClusterNotice, time.Time) {
+	first := event.EventTime.Time
+	if first.IsZero() {
+		first = event.DeprecatedFirstTimestamp.Time
+	}
+
+	last, count := first, int32(1)
+	if event.Series != nil {
+		last, count = event.Series.LastObservedTime.Time, event.Series.Count
+	} else if !event.DeprecatedLastTimestamp.IsZero() {
+		last, count = event.DeprecatedLastTimestamp.Time, event.DeprecatedCount
+	}
+
+	source, host := event.ReportingController, event.ReportingInstance
+	if source == "" {
+		source, host = event.DeprecatedSource.Component, event.DeprecatedSource.Host
+	}
+
+	notice := &protobuf.ClusterNotice{
+		Cluster:   cluster,
+		TimeStamp: strconv.FormatInt(last.Unix(), 10),
+
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Note,
+		Count:   count,
+
+		Kind:      event.Regarding.Kind,
+		Namespace: event.Regarding.Namespace,
+		Name:      event.Regarding.Name,
+		FieldPath: event.Regarding.FieldPath,
+
+		Source:         source,
+		Host:           host,
+		FirstTimestamp: first.Format(time.RFC3339),
+		LastTimestamp:  last.Format(time.RFC3339),
+	}
+
+	return notice, last
+}
+
+// convertCoreEventToNotice Function that converts a core/v1 Event
+func convertCoreEventToNotice(cluster string, event *corev1.Event) (*protobuf.ClusterNotice, time.Time) {
+	first := event.FirstTimestamp.Time
+	if first.IsZero() {
+		first = event.EventTime.Time
+	}
+
+	last, count := event.LastTimestamp.Time, event.Count
+	if event.Series != nil {
+		last, count = event.Series.LastObservedTime.Time, event.Series.Count
+	}
+	if last.IsZero() {
+		last = first
+	}
+	if count == 0 {
+		count = 1
+	}
+
+	source, host := event.Source.Component, event.Source.Host
+	if source == "" {
+		source, host = event.ReportingController, event.ReportingInstance
+	}
+
+	notice := &protobuf.ClusterNotice{
+		Cluster:   cluster,
+		TimeStamp: strconv.FormatInt(last.Unix(), 10),
+
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Message,
+		Count:   count,
+
+		Kind:      event.InvolvedObject.Kind,
+		Namespace: event.InvolvedObject.Namespace,
+		Name:      event.InvolvedObject.Name,
+		FieldPath: event.InvolvedObject.FieldPath,
+
+		Source:         source,
+		Host:           host,
+		FirstTimestamp: first.Format(time.RFC3339),
+		LastTimestamp:  last.Format(time.RFC3339),
+	}
+
+	return notice, last
+}
+
+// == //
@@ -0,0 +1,562 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"Agent/types"
+	"Agent/uploader"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// == //
+
+// ingressTarget Structure that describes a watched north-south resource
+type ingressTarget struct {
+	target string
+	kind   string
+	gvr    schema.GroupVersionResource
+}
+
+// ingressTargets that are watched through the dynamic client (CRDs)
+var ingressTargets = []ingressTarget{
+	{
+		target: "gateways.gateway.networking.k8s.io",
+		kind:   "Gateway",
+		gvr:    schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways"},
+	},
+	{
+		target: "httproutes.gateway.networking.k8s.io",
+		kind:   "HTTPRoute",
+		gvr:    schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"},
+	},
+	{
+		target: "gateways.networking.istio.io",
+		kind:   "IstioGateway",
+		gvr:    schema.GroupVersionResource{Group: "networking.istio.io", Version: "v1beta1", Resource: "gateways"},
+	},
+	{
+		target: "virtualservices.networking.istio.io",
+		kind:   "VirtualService",
+		gvr:    schema.GroupVersionResource{Group: "networking.istio.io", Version: "v1beta1", Resource: "virtualservices"},
+	},
+}
+
+// ingressKey Function
+func ingressKey(ing *types.Ingress) string {
+	return fmt.Sprintf("%s/%s/%s", ing.Kind, ing.Namespace, ing.Name)
+}
+
+// == //
+
+// initIngressWatchers Function that initializes watchers for Ingresses, Gateway API and Istio routing resources
+func (k8s *KubernetesHandler) initIngressWatchers() {
+	k8s.watchers["ingresses"] = cache.NewListWatchFromClient(
+		k8s.clientSet.NetworkingV1().RESTClient(),
+		"ingresses",
+		corev1.NamespaceAll,
+		fields.Everything(),
+	)
+
+	for _, it := range ingressTargets {
+		if !k8s.isResourceServed(it.gvr) {
+			log.Printf("[InitK8sClient] %s is not served by the cluster, skipping", it.target)
+			continue
+		}
+
+		gvr := it.gvr
+		k8s.watchers[it.target] = &cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				return k8s.dynamicClient.Resource(gvr).Namespace(corev1.NamespaceAll).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				return k8s.dynamicClient.Resource(gvr).Namespace(corev1.NamespaceAll).Watch(context.Background(), options)
+			},
+		}
+	}
+}
+
+// isResourceServed Function that checks if a resource (e.g., CRD) is available in the cluster
+func (k8s *KubernetesHandler) isResourceServed(gvr schema.GroupVersionResource) bool {
+	resources, err := k8s.clientSet.Discovery().ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+	if err != nil {
+		return false
+	}
+
+	for _, res := range resources.APIResources {
+		if res.Name == gvr.Resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// initIngressInformers Function that initializes informers for north-south routing resources
+func (k8s *KubernetesHandler) initIngressInformers() {
+	if k8s.watchers["ingresses"] != nil {
+		_, ingController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["ingresses"],
+				ObjectType:    &networkingv1.Ingress{},
+				ResyncPeriod:  0,
+				Handler:       k8s.ingressEventHandler("Ingress"),
+			},
+		)
+		k8s.informers["ingresses"] = ingController
+	}
+
+	for _, it := range ingressTargets {
+		if k8s.watchers[it.target] == nil {
+			continue
+		}
+
+		_, controller := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers[it.target],
+				ObjectType:    &unstructured.Unstructured{},
+				ResyncPeriod:  0,
+				Handler:       k8s.ingressEventHandler(it.kind),
+			},
+		)
+		k8s.informers[it.target] = controller
+	}
+}
+
+// ingressEventHandler Function
+func (k8s *KubernetesHandler) ingressEventHandler(kind string) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			ing := convertToIngress(kind, obj)
+			if ing == nil {
+				return
+			}
+			key := ingressKey(ing)
+			k8s.ingressLock.Lock()
+			k8s.ingressMap[key] = ing
+			k8s.ingressLock.Unlock()
+			log.Printf("[Informer:Ingress] ADDED %s", key)
+			go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Ingress", "ADD", ing)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			ing := convertToIngress(kind, newObj)
+			if ing == nil {
+				return
+			}
+			key := ingressKey(ing)
+			k8s.ingressLock.Lock()
+			k8s.ingressMap[key] = ing
+			k8s.ingressLock.Unlock()
+			log.Printf("[Informer:Ingress] UPDATED %s", key)
+			go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Ingress", "UPDATE", ing)
+		},
+		DeleteFunc: func(obj interface{}) {
+			ing := convertToIngress(kind, obj)
+			if ing == nil {
+				return
+			}
+			key := ingressKey(ing)
+			k8s.ingressLock.Lock()
+			delete(k8s.ingressMap, key)
+			k8s.ingressLock.Unlock()
+			log.Printf("[Informer:Ingress] DELETED %s", key)
+			go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Ingress", "DELETE", ing)
+		},
+	}
+}
+
+// == //
+
+// convertToIngress Function that normalizes a routing resource into types.Ingress
+func convertToIngress(kind string, obj interface{}) *types.Ingress {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	switch o := obj.(type) {
+	case *networkingv1.Ingress:
+		return convertNetworkingIngress(o)
+	case *unstructured.Unstructured:
+		switch kind {
+		case "Gateway":
+			return convertGatewayAPIGateway(o)
+		case "HTTPRoute":
+			return convertGatewayAPIHTTPRoute(o)
+		case "IstioGateway":
+			return convertIstioGateway(o)
+		case "VirtualService":
+			return convertIstioVirtualService(o)
+		}
+	}
+
+	return nil
+}
+
+// newIngressFromMeta Function
+func newIngressFromMeta(kind string, meta v1.Object) *types.Ingress {
+	return &types.Ingress{
+		Namespace:         meta.GetNamespace(),
+		Name:              meta.GetName(),
+		Kind:              kind,
+		Labels:            meta.GetLabels(),
+		CreationTimestamp: meta.GetCreationTimestamp().String(),
+	}
+}
+
+// convertNetworkingIngress Function
+func convertNetworkingIngress(ingress *networkingv1.Ingress) *types.Ingress {
+	ing := newIngressFromMeta("Ingress", ingress)
+
+	if ingress.Spec.IngressClassName != nil {
+		ing.ClassName = *ingress.Spec.IngressClassName
+	} else {
+		ing.ClassName = ingress.Annotations["kubernetes.io/ingress.class"]
+	}
+
+	for _, lb := range ingress.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			ing.Addresses = append(ing.Addresses, lb.IP)
+		} else if lb.Hostname != "" {
+			ing.Addresses = append(ing.Addresses, lb.Hostname)
+		}
+	}
+
+	if backend := ingress.Spec.DefaultBackend; backend != nil && backend.Service != nil {
+		ing.Rules = append(ing.Rules, types.IngressRule{
+			BackendNamespace: ingress.Namespace,
+			BackendService:   backend.Service.Name,
+			BackendPort:      backend.Service.Port.Number,
+		})
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host != "" {
+			ing.Hosts = append(ing.Hosts, rule.Host)
+		}
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service == nil {
+				continue
+			}
+			ing.Rules = append(ing.Rules, types.IngressRule{
+				Host:             rule.Host,
+				Path:             path.Path,
+				BackendNamespace: ingress.Namespace,
+				BackendService:   path.Backend.Service.Name,
+				BackendPort:      path.Backend.Service.Port.Number,
+			})
+		}
+	}
+
+	return ing
+}
+
+// convertGatewayAPIGateway Function
+func convertGatewayAPIGateway(obj *unstructured.Unstructured) *types.Ingress {
+	ing := newIngressFromMeta("Gateway", obj)
+
+	ing.ClassName, _, _ = unstructured.NestedString(obj.Object, "spec", "gatewayClassName")
+
+	listeners, _, _ := unstructured.NestedSlice(obj.Object, "spec", "listeners")
+	for _, l := range listeners {
+		listener, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if hostname, _, _ := unstructured.NestedString(listener, "hostname"); hostname != "" {
+			ing.Hosts = append(ing.Hosts, hostname)
+			ing.Rules = append(ing.Rules, types.IngressRule{Host: hostname})
+		}
+	}
+
+	addresses, _, _ := unstructured.NestedSlice(obj.Object, "status", "addresses")
+	for _, a := range addresses {
+		address, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, _, _ := unstructured.NestedString(address, "value"); value != "" {
+			ing.Addresses = append(ing.Addresses, value)
+		}
+	}
+
+	return ing
+}
+
+// convertGatewayAPIHTTPRoute Function
+func convertGatewayAPIHTTPRoute(obj *unstructured.Unstructured) *types.Ingress {
+	ing := newIngressFromMeta("HTTPRoute", obj)
+
+	ing.Hosts, _, _ = unstructured.NestedStringSlice(obj.Object, "spec", "hostnames")
+
+	parentRefs, _, _ := unstructured.NestedSlice(obj.Object, "spec", "parentRefs")
+	for _, p := range parentRefs {
+		parent, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(parent, "name")
+		namespace, _, _ := unstructured.NestedString(parent, "namespace")
+		if namespace == "" {
+			namespace = ing.Namespace
+		}
+		ing.Gateways = append(ing.Gateways, fmt.Sprintf("%s/%s", namespace, name))
+	}
+
+	hosts := ing.Hosts
+	if len(hosts) == 0 {
+		hosts = []string{""}
+	}
+
+	rules, _, _ := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		paths := []string{}
+		matches, _, _ := unstructured.NestedSlice(rule, "matches")
+		for _, m := range matches {
+			match, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			path, _, _ := unstructured.NestedString(match, "path", "value")
+			paths = append(paths, path)
+		}
+		if len(paths) == 0 {
+			paths = append(paths, "/")
+		}
+
+		backendRefs, _, _ := unstructured.NestedSlice(rule, "backendRefs")
+		for _, b := range backendRefs {
+			backend, ok := b.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(backend, "name")
+			namespace, _, _ := unstructured.NestedString(backend, "namespace")
+			if namespace == "" {
+				namespace = ing.Namespace
+			}
+			port, _, _ := unstructured.NestedInt64(backend, "port")
+
+			for _, host := range hosts {
+				for _, path := range paths {
+					ing.Rules = append(ing.Rules, types.IngressRule{
+						Host:             host,
+						Path:             path,
+						BackendNamespace: namespace,
+						BackendService:   name,
+						BackendPort:      int32(port),
+					})
+				}
+			}
+		}
+	}
+
+	return ing
+}
+
+// convertIstioGateway Function
+func convertIstioGateway(obj *unstructured.Unstructured) *types.Ingress {
+	ing := newIngressFromMeta("IstioGateway", obj)
+
+	selector, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "selector")
+	selectors := make([]string, 0, len(selector))
+	for k, v := range selector {
+		selectors = append(selectors, fmt.Sprintf("%s=%s", k, v))
+	}
+	ing.ClassName = strings.Join(selectors, ",")
+
+	servers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "servers")
+	for _, s := range servers {
+		server, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		hosts, _, _ := unstructured.NestedStringSlice(server, "hosts")
+		for _, host := range hosts {
+			// hosts can be in the form of "namespace/host"
+			if idx := strings.Index(host, "/"); idx >= 0 {
+				host = host[idx+1:]
+			}
+			ing.Hosts = append(ing.Hosts, host)
+			ing.Rules = append(ing.Rules, types.IngressRule{Host: host})
+		}
+	}
+
+	return ing
+}
+
+// convertIstioVirtualService Function
+func convertIstioVirtualService(obj *unstructured.Unstructured) *types.Ingress {
+	ing := newIngressFromMeta("VirtualService", obj)
+
+	ing.Hosts, _, _ = unstructured.NestedStringSlice(obj.Object, "spec", "hosts")
+
+	gateways, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "gateways")
+	for _, gw := range gateways {
+		if gw != "mesh" && !strings.Contains(gw, "/") {
+			gw = fmt.Sprintf("%s/%s", ing.Namespace, gw)
+		}
+		ing.Gateways = append(ing.Gateways, gw)
+	}
+
+	hosts := ing.Hosts
+	if len(hosts) == 0 {
+		hosts = []string{""}
+	}
+
+	httpRoutes, _, _ := unstructured.NestedSlice(obj.Object, "spec", "http")
+	for _, h := range httpRoutes {
+		httpRoute, ok := h.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		paths := []string{}
+		matches, _, _ := unstructured.NestedSlice(httpRoute, "match")
+		for _, m := range matches {
+			match, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if prefix, found, _ := unstructured.NestedString(match, "uri", "prefix"); found {
+				paths = append(paths, prefix)
+			} else if exact, found, _ := unstructured.NestedString(match, "uri", "exact"); found {
+				paths = append(paths, exact)
+			}
+		}
+		if len(paths) == 0 {
+			paths = append(paths, "")
+		}
+
+		routes, _, _ := unstructured.NestedSlice(httpRoute, "route")
+		for _, r := range routes {
+			route, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			destHost, _, _ := unstructured.NestedString(route, "destination", "host")
+			port, _, _ := unstructured.NestedInt64(route, "destination", "port", "number")
+			svcName, svcNamespace := splitServiceHost(destHost, ing.Namespace)
+
+			for _, host := range hosts {
+				for _, path := range paths {
+					ing.Rules = append(ing.Rules, types.IngressRule{
+						Host:             host,
+						Path:             path,
+						BackendNamespace: svcNamespace,
+						BackendService:   svcName,
+						BackendPort:      int32(port),
+					})
+				}
+			}
+		}
+	}
+
+	return ing
+}
+
+// splitServiceHost Function that splits "name[.namespace[.svc.cluster.local]]" into name and namespace
+func splitServiceHost(host, defaultNamespace string) (string, string) {
+	parts := strings.Split(host, ".")
+	if len(parts) == 1 {
+		return parts[0], defaultNamespace
+	}
+	return parts[0], parts[1]
+}
+
+// == //
+
+// hostMatches Function
+func hostMatches(pattern, host string) (bool, bool) {
+	if pattern == "" || pattern == "*" {
+		return true, false
+	}
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(host, pattern[1:]), false
+	}
+	return strings.EqualFold(pattern, host), true
+}
+
+// LookupIngressRoute Function that finds the routing resource matching the given host and path
+func LookupIngressRoute(host, path string) types.K8sResource {
+	ret := types.K8sResource{
+		Cluster:   "Unknown",
+		Namespace: "Unknown",
+		Name:      "Unknown",
+		Labels:    make(map[string]string),
+		Type:      types.K8sResourceTypeUnknown,
+	}
+
+	if host == "" {
+		return ret
+	}
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	var matched *types.Ingress
+	var matchedCluster string
+	bestScore := -1
+
+	for _, k8s := range K8sHandlers {
+		k8s.ingressLock.RLock()
+		for _, ing := range k8s.ingressMap {
+			for _, rule := range ing.Rules {
+				ok, exact := hostMatches(rule.Host, host)
+				if !ok || !strings.HasPrefix(path, rule.Path) {
+					continue
+				}
+
+				// prefer exact hosts, then longer paths, then routes with backends
+				score := len(rule.Path) * 2
+				if exact {
+					score += 10000
+				}
+				if rule.BackendService != "" {
+					score++
+				}
+
+				if score > bestScore {
+					bestScore = score
+					matched = ing
+					matchedCluster = k8s.clusterName
+				}
+			}
+		}
+		k8s.ingressLock.RUnlock()
+	}
+
+	if matched != nil {
+		ret.Cluster = matchedCluster
+		ret.Namespace = matched.Namespace
+		ret.Name = fmt.Sprintf("%s/%s", matched.Kind, matched.Name)
+		ret.Labels = matched.Labels
+		ret.Type = types.K8sResourceTypeIngress
+	}
+
+	return ret
+}
+
+// == //
@@ -3,98 +3,138 @@
 package k8s
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"log"
+	"net"
+	"strings"
 
-	"gopkg.in/yaml.v2"
+	"Agent/config"
+
+	"gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/util/json"
 )
 
-// meshConfig structure
-type meshConfig struct {
-	DefaultConfig struct {
-		DiscoveryAddress      string `yaml:"discoveryAddress"`
-		EnvoyAccessLogService struct {
-			Address string `yaml:"address"`
-		} `yaml:"envoyAccessLogService"`
-		EnvoyMetricsService struct {
-			Address string `yaml:"address"`
-		} `yaml:"envoyMetricsService"`
-	} `yaml:"defaultConfig"`
+// == //
+
+// agentProviderName is the extension provider registered in the Istio mesh config
+const agentProviderName = "sentryflow-agent"
+
+// annotationOriginalMeshConfig records the mesh config values as they were before patching
+const annotationOriginalMeshConfig = "sentryflow.io/original-mesh-config"
+
+// Telemetry modes
+const (
+	TelemetryModeConfigMap = "configmap" // point every proxy at Agent through the mesh config
+	TelemetryModeTelemetry = "telemetry" // register a provider and enable it with Telemetry resources
+)
 
-	DefaultProviders struct {
-		AccessLogs []string `yaml:"accessLogs"`
-		Metrics    []string `yaml:"metrics"`
-	} `yaml:"defaultProviders"`
+// meshField structure that describes a scalar managed by Agent in the mesh config
+type meshField struct {
+	path  []string
+	value string
+	tag   string
+}
 
-	EnableEnvoyAccessLogService bool `yaml:"enableEnvoyAccessLogService"`
+// managedMeshFields Function that returns the scalars Agent sets in the mesh config for a mode
+func managedMeshFields(mode string) []meshField {
+	// With the Telemetry API, only the extension provider is registered in the mesh config
+	if mode == TelemetryModeTelemetry {
+		return nil
+	}
 
-	ExtensionProviders []struct {
-		EnvoyOtelAls struct {
-			Port    string `yaml:"port"`
-			Service string `yaml:"service"`
-		} `yaml:"envoyOtelAls"`
-		Name string `yaml:"name"`
-	} `yaml:"extensionProviders"`
+	return []meshField{
+		{path: []string{"defaultConfig", "envoyAccessLogService", "address"}, value: agentServiceAddr(), tag: "!!str"},
+		{path: []string{"defaultConfig", "envoyMetricsService", "address"}, value: agentServiceAddr(), tag: "!!str"},
+		{path: []string{"enableEnvoyAccessLogService"}, value: "true", tag: "!!bool"},
+	}
+}
 
-	ExtraFields map[string]interface{} `yaml:",inline"` // all extra fields that Agent will not touch
+// agentServiceAddr Function that returns the address Envoy proxies use to reach Agent
+func agentServiceAddr() string {
+	return net.JoinHostPort(config.GlobalConfig.AgentServiceHost, config.GlobalConfig.CollectorPort)
 }
 
+// istioConfigMapName Function that returns the mesh ConfigMap of the configured revision
+func istioConfigMapName() string {
+	if config.GlobalConfig.IstioRevision == "" || config.GlobalConfig.IstioRevision == "default" {
+		return "istio"
+	}
+	return "istio-" + config.GlobalConfig.IstioRevision
+}
+
+// meshPatchRecord structure that is stored in the ConfigMap annotation
+type meshPatchRecord struct {
+	Values                 map[string]*string `json:"values"` // nil when the field did not exist
+	AddedExtensionProvider bool               `json:"addedExtensionProvider"`
+	ExtensionProvider      string             `json:"extensionProvider,omitempty"` // name of the added provider
+	AddedAccessLogProvider bool               `json:"addedAccessLogProvider"`
+	AddedMetricsProvider   bool               `json:"addedMetricsProvider,omitempty"`
+}
+
+// prometheusProviderName is the metrics provider that makes ztunnel and waypoints export Istio metrics
+const prometheusProviderName = "prometheus"
+
+// == //
+
 // PatchIstioConfigMap Function
 func PatchIstioConfigMap() bool {
 	log.Print("[PatchIstioConfigMap] Patching Istio ConfigMap")
 
-	meshCfg, err := parseIstioConfigMap()
+	cm, err := K8sH.getConfigMap(config.GlobalConfig.IstioNamespace, istioConfigMapName())
+	if err != nil {
+		log.Printf("[PatchIstioConfigMap] Unable to get Istio ConfigMap: %v", err)
+		return false
+	}
+
+	doc, root, err := parseMeshConfig(cm.Data["mesh"])
 	if err != nil {
 		log.Printf("[PatchIstioConfigMap] Unable to parse Istio ConfigMap: %v", err)
 		return false
 	}
 
-	if isIstioAlreadyPatched(meshCfg) {
+	if isIstioAlreadyPatched(root) {
 		log.Print("[PatchIstioConfigMap] Istio ConfigMap was already patched before, skipping...")
 		return true
 	}
 
-	// set metrics and envoy access logging to Agent
-	meshCfg.DefaultConfig.EnvoyAccessLogService.Address = "sentryflow-agent.sentryflow.svc.cluster.local:4317"
-	meshCfg.DefaultConfig.EnvoyMetricsService.Address = "sentryflow-agent.sentryflow.svc.cluster.local:4317"
-
-	// add Agent as Otel AL collector
-	if patched, _ := isEnvoyOtelAlPatched(meshCfg); !patched {
-		sfOtelAl := struct {
-			EnvoyOtelAls struct {
-				Port    string `yaml:"port"`
-				Service string `yaml:"service"`
-			} `yaml:"envoyOtelAls"`
-			Name string `yaml:"name"`
-		}{
-			EnvoyOtelAls: struct {
-				Port    string `yaml:"port"`
-				Service string `yaml:"service"`
-			}{
-				Port:    "4317",
-				Service: "sentryflow-agent.sentryflow.svc.cluster.local",
-			},
-			Name: "sentryflow-agent",
+	// Keep the record of a previous run, it holds the values from before Agent
+	record := meshPatchRecord{Values: make(map[string]*string)}
+	if original, ok := cm.Annotations[annotationOriginalMeshConfig]; ok {
+		if err := json.Unmarshal([]byte(original), &record); err != nil {
+			log.Printf("[PatchIstioConfigMap] Unable to read the original Istio ConfigMap: %v", err)
+			return false
+		}
+		if record.Values == nil {
+			record.Values = make(map[string]*string)
 		}
-		meshCfg.ExtensionProviders = append(meshCfg.ExtensionProviders, sfOtelAl)
 	}
 
-	// add default access log provider
-	if patched, _ := isEnvoyALProviderPatched(meshCfg); !patched {
-		meshCfg.DefaultProviders.AccessLogs = append(meshCfg.DefaultProviders.AccessLogs, "sentryflow-agent")
+	// Fields of an unexpected kind are left to the user instead of being overwritten
+	if err := patchMeshConfig(root, &record); err != nil {
+		log.Printf("[PatchIstioConfigMap] Refusing to patch Istio ConfigMap: %v", err)
+		return false
 	}
 
-	meshCfg.EnableEnvoyAccessLogService = true
+	strMeshCfg, err := encodeMeshConfig(doc)
+	if err != nil {
+		log.Printf("[PatchIstioConfigMap] Unable to marshall Istio ConfigMap: %v", err)
+		return false
+	}
+	cm.Data["mesh"] = strMeshCfg
 
-	yamlMeshCfg, err := yaml.Marshal(meshCfg)
+	data, err := json.Marshal(record)
 	if err != nil {
-		log.Printf("[PatchIstioConfigMap] Unable to unmarshall Istio ConfigMap: %v", err)
+		log.Printf("[PatchIstioConfigMap] Unable to record original Istio ConfigMap: %v", err)
 		return false
 	}
+	if cm.Annotations == nil {
+		cm.Annotations = make(map[string]string)
+	}
+	cm.Annotations[annotationOriginalMeshConfig] = string(data)
 
-	strMeshCfg := string(yamlMeshCfg[:])
-	err = K8sH.updateConfigMap("istio-system", "istio", strMeshCfg)
+	err = K8sH.updateConfigMap(cm)
 	if err != nil {
 		log.Printf("[PatchIstioConfigMap] Unable to update Istio ConfigMap: %v", err)
 		return false
@@ -109,56 +149,90 @@ func PatchIstioConfigMap() bool {
 func UnpatchIstioConfigMap() bool {
 	log.Print("[PatchIstioConfigMap] Unpatching Istio ConfigMap")
 
-	meshCfg, err := parseIstioConfigMap()
+	if K8sH.clientSet == nil {
+		return false
+	}
+
+	cm, err := K8sH.getConfigMap(config.GlobalConfig.IstioNamespace, istioConfigMapName())
+	if err != nil {
+		log.Printf("[PatchIstioConfigMap] Unable to get Istio ConfigMap: %v", err)
+		return false
+	}
+
+	original, ok := cm.Annotations[annotationOriginalMeshConfig]
+	if !ok {
+		log.Print("[PatchIstioConfigMap] No record of the original Istio ConfigMap, leaving it unchanged")
+		return true
+	}
+
+	record := meshPatchRecord{}
+	if err := json.Unmarshal([]byte(original), &record); err != nil {
+		log.Printf("[PatchIstioConfigMap] Unable to read the original Istio ConfigMap: %v", err)
+		return false
+	}
+
+	doc, root, err := parseMeshConfig(cm.Data["mesh"])
 	if err != nil {
 		log.Printf("[PatchIstioConfigMap] Unable to parse Istio ConfigMap: %v", err)
 		return false
 	}
 
-	// set metrics and envoy access logging back to empty value
-	meshCfg.DefaultConfig.EnvoyAccessLogService.Address = ""
-	meshCfg.DefaultConfig.EnvoyMetricsService.Address = ""
-
-	// remove EnvoyOtelAl
-	if patched, targetIdx := isEnvoyOtelAlPatched(meshCfg); patched {
-		tmp := make([]struct {
-			EnvoyOtelAls struct {
-				Port    string `yaml:"port"`
-				Service string `yaml:"service"`
-			} `yaml:"envoyOtelAls"`
-			Name string `yaml:"name"`
-		}, 0)
-		for idx, envoyOtelAl := range meshCfg.ExtensionProviders {
-			if idx != targetIdx {
-				tmp = append(tmp, envoyOtelAl)
-			}
+	// restore metrics and envoy access logging to the values from before Agent (whatever mode patched them)
+	for _, field := range managedMeshFields(TelemetryModeConfigMap) {
+		value, found := record.Values[strings.Join(field.path, ".")]
+		if !found {
+			continue
+		}
+		if value == nil {
+			deleteMeshPath(root, field.path)
+		} else if err := setMeshPath(root, field.path, *value, field.tag); err != nil {
+			log.Printf("[PatchIstioConfigMap] Unable to restore Istio ConfigMap: %v", err)
+			return false
+		}
+	}
+
+	// records from before the provider name was kept only hold the provider of Agent
+	providerName := record.ExtensionProvider
+	if providerName == "" {
+		providerName = agentProviderName
+	}
+
+	// remove EnvoyOtelAl (by name, the port or service of Agent may have changed since)
+	if idx := findExtensionProvider(root, providerName); idx >= 0 && record.AddedExtensionProvider {
+		providers := lookupMeshPath(root, []string{"extensionProviders"})
+		providers.Content = append(providers.Content[:idx], providers.Content[idx+1:]...)
+		if len(providers.Content) == 0 {
+			deleteMeshPath(root, []string{"extensionProviders"})
 		}
-		meshCfg.ExtensionProviders = tmp
 	}
 
 	// remove default access log provider
-	if patched, targetIdx := isEnvoyALProviderPatched(meshCfg); patched {
-		tmp := make([]string, 0)
-		for idx, provider := range meshCfg.DefaultProviders.AccessLogs {
-			if idx != targetIdx {
-				tmp = append(tmp, provider)
-			}
+	if idx := findEnvoyALProvider(root); idx >= 0 && record.AddedAccessLogProvider {
+		accessLogs := lookupMeshPath(root, []string{"defaultProviders", "accessLogs"})
+		accessLogs.Content = append(accessLogs.Content[:idx], accessLogs.Content[idx+1:]...)
+		if len(accessLogs.Content) == 0 {
+			deleteMeshPath(root, []string{"defaultProviders", "accessLogs"})
 		}
-		meshCfg.DefaultProviders.AccessLogs = tmp
 	}
 
-	// @todo this might be incorrect, the user might have just set up envoy access log service manually before.
-	// @todo check if this shall actually be overwritten by Agent
-	// meshCfg.EnableEnvoyAccessLogService = false
+	// remove default metrics provider
+	if idx := findMetricsProvider(root, prometheusProviderName); idx >= 0 && record.AddedMetricsProvider {
+		metrics := lookupMeshPath(root, []string{"defaultProviders", "metrics"})
+		metrics.Content = append(metrics.Content[:idx], metrics.Content[idx+1:]...)
+		if len(metrics.Content) == 0 {
+			deleteMeshPath(root, []string{"defaultProviders", "metrics"})
+		}
+	}
 
-	yamlMeshCfg, err := yaml.Marshal(meshCfg)
+	strMeshCfg, err := encodeMeshConfig(doc)
 	if err != nil {
-		log.Printf("[PatchIstioConfigMap] Unable to unmarshall Istio ConfigMap: %v", err)
+		log.Printf("[PatchIstioConfigMap] Unable to marshall Istio ConfigMap: %v", err)
 		return false
 	}
+	cm.Data["mesh"] = strMeshCfg
+	delete(cm.Annotations, annotationOriginalMeshConfig)
 
-	strMeshCfg := string(yamlMeshCfg[:])
-	err = K8sH.updateConfigMap("istio-system", "istio", strMeshCfg)
+	err = K8sH.updateConfigMap(cm)
 	if err != nil {
 		log.Printf("[PatchIstioConfigMap] Unable to update Istio ConfigMap: %v", err)
 		return false
@@ -169,78 +243,343 @@ func UnpatchIstioConfigMap() bool {
 	return true
 }
 
-// parseIstioConfigMap Function
-func parseIstioConfigMap() (meshConfig, error) {
-	var meshCfg meshConfig
+// == //
 
-	configMapData, err := K8sH.getConfigMap("istio-system", "istio")
-	if err != nil {
-		return meshCfg, err
+// parseMeshConfig Function that parses the mesh config while keeping unknown fields and comments
+func parseMeshConfig(meshData string) (*yaml.Node, *yaml.Node, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(meshData), doc); err != nil {
+		return nil, nil, err
 	}
 
-	// unmarshall JSON format of Istio config
-	var rawIstioCfg map[string]interface{}
-	err = json.Unmarshal([]byte(configMapData), &rawIstioCfg)
-	if err != nil {
-		return meshCfg, err
+	// empty mesh config
+	if doc.Kind == 0 {
+		doc.Kind = yaml.DocumentNode
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
 	}
 
-	// extract mesh field from configmap
-	meshData, ok := rawIstioCfg["mesh"].(string)
-	if !ok {
-		return meshCfg, errors.New("[PatchIstioConfigMap] Unable to find field \"mesh\" from Istio config")
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil, errors.New("[PatchIstioConfigMap] Istio mesh config is not a YAML mapping")
 	}
 
-	// unmarshall YAML format of Istio config
-	err = yaml.Unmarshal([]byte(meshData), &meshCfg)
+	return doc, doc.Content[0], nil
+}
+
+// encodeMeshConfig Function
+func encodeMeshConfig(doc *yaml.Node) (string, error) {
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(doc); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// lookupMeshKey Function that returns the index of a key in a mapping node
+func lookupMeshKey(node *yaml.Node, key string) int {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return -1
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// lookupMeshPath Function that returns the node at the given path
+func lookupMeshPath(node *yaml.Node, path []string) *yaml.Node {
+	for _, key := range path {
+		idx := lookupMeshKey(node, key)
+		if idx < 0 {
+			return nil
+		}
+		node = node.Content[idx+1]
+	}
+	return node
+}
+
+// ensureMeshMapping Function that returns the mapping under a key, creating it if needed
+// (an error if the key holds something else)
+func ensureMeshMapping(node *yaml.Node, key string) (*yaml.Node, error) {
+	if idx := lookupMeshKey(node, key); idx >= 0 {
+		child := node.Content[idx+1]
+		if child.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("%s is not a YAML mapping", key)
+		}
+		return child, nil
+	}
+
+	child := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, child)
+	return child, nil
+}
+
+// ensureMeshSequence Function that returns the sequence under a key, creating it if needed
+// (an error if the key holds something else)
+func ensureMeshSequence(node *yaml.Node, key string) (*yaml.Node, error) {
+	if idx := lookupMeshKey(node, key); idx >= 0 {
+		child := node.Content[idx+1]
+		if child.Kind != yaml.SequenceNode {
+			return nil, fmt.Errorf("%s is not a YAML sequence", key)
+		}
+		return child, nil
+	}
+
+	child := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, child)
+	return child, nil
+}
+
+// setMeshPath Function that sets a scalar at the given path
+// (an error if the path runs through or ends at something that is not what it expects)
+func setMeshPath(node *yaml.Node, path []string, value, tag string) error {
+	for _, key := range path[:len(path)-1] {
+		child, err := ensureMeshMapping(node, key)
+		if err != nil {
+			return err
+		}
+		node = child
+	}
+
+	key := path[len(path)-1]
+	if idx := lookupMeshKey(node, key); idx >= 0 {
+		child := node.Content[idx+1]
+		if child.Kind != yaml.ScalarNode {
+			return fmt.Errorf("%s is not a YAML scalar", strings.Join(path, "."))
+		}
+		child.Tag = tag
+		child.Value = value
+		child.Style = 0
+		return nil
+	}
+
+	node.Content = append(node.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value})
+	return nil
+}
+
+// appendMeshScalar Function that appends a scalar to the sequence at the given path
+func appendMeshScalar(node *yaml.Node, path []string, value string) error {
+	for _, key := range path[:len(path)-1] {
+		child, err := ensureMeshMapping(node, key)
+		if err != nil {
+			return err
+		}
+		node = child
+	}
+
+	seq, err := ensureMeshSequence(node, path[len(path)-1])
 	if err != nil {
-		return meshCfg, err
+		return err
+	}
+	seq.Content = append(seq.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+	return nil
+}
+
+// deleteMeshPath Function that removes the node at the given path and any mapping left empty by it
+func deleteMeshPath(node *yaml.Node, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	idx := lookupMeshKey(node, path[0])
+	if idx < 0 {
+		return
+	}
+
+	if len(path) > 1 {
+		child := node.Content[idx+1]
+		deleteMeshPath(child, path[1:])
+		if child.Kind != yaml.MappingNode || len(child.Content) > 0 {
+			return
+		}
 	}
 
-	return meshCfg, nil
+	node.Content = append(node.Content[:idx], node.Content[idx+2:]...)
 }
 
-// isEnvoyOtelAlPatched Function
-func isEnvoyOtelAlPatched(meshCfg meshConfig) (bool, int) {
-	for idx, envoyOtelAl := range meshCfg.ExtensionProviders {
-		if envoyOtelAl.Name == "sentryflow-agent" &&
-			envoyOtelAl.EnvoyOtelAls.Port == "4317" &&
-			envoyOtelAl.EnvoyOtelAls.Service == "sentryflow-agent.sentryflow.svc.cluster.local" {
-			return true, idx
+// newEnvoyOtelAlNode Function
+func newEnvoyOtelAlNode() *yaml.Node {
+	return &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: "envoyOtelAls"},
+			{
+				Kind: yaml.MappingNode,
+				Tag:  "!!map",
+				Content: []*yaml.Node{
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: "port"},
+					{Kind: yaml.ScalarNode, Tag: "!!int", Value: config.GlobalConfig.CollectorPort},
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: "service"},
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: config.GlobalConfig.AgentServiceHost},
+				},
+			},
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: "name"},
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: agentProviderName},
+		},
+	}
+}
+
+// patchMeshConfig Function that sets Agent in the mesh config and keeps what it changes in a record
+// (on an error, the mesh config is left half patched and must be discarded)
+func patchMeshConfig(root *yaml.Node, record *meshPatchRecord) error {
+	// set metrics and envoy access logging to Agent
+	for _, field := range managedMeshFields(config.GlobalConfig.TelemetryMode) {
+		key := strings.Join(field.path, ".")
+
+		// a recorded field already holds the value from before Agent
+		if _, recorded := record.Values[key]; !recorded {
+			if node := lookupMeshPath(root, field.path); node != nil {
+				value := node.Value
+				record.Values[key] = &value
+			} else {
+				record.Values[key] = nil
+			}
+		}
+		if err := setMeshPath(root, field.path, field.value, field.tag); err != nil {
+			return err
 		}
 	}
 
-	return false, -1
+	// add Agent as Otel AL collector, or point the provider of Agent at its current service and port
+	// (Istio rejects two providers with the same name)
+	if provider := findAgentProvider(root); provider == nil {
+		providers, err := ensureMeshSequence(root, "extensionProviders")
+		if err != nil {
+			return err
+		}
+		providers.Content = append(providers.Content, newEnvoyOtelAlNode())
+		record.AddedExtensionProvider = true
+		record.ExtensionProvider = agentProviderName
+	} else if !isEnvoyOtelAlCurrent(provider) {
+		if err := setMeshPath(provider, []string{"envoyOtelAls", "port"}, config.GlobalConfig.CollectorPort, "!!int"); err != nil {
+			return err
+		}
+		if err := setMeshPath(provider, []string{"envoyOtelAls", "service"}, config.GlobalConfig.AgentServiceHost, "!!str"); err != nil {
+			return err
+		}
+	}
+
+	// add default access log provider (Telemetry resources select the provider instead)
+	if idx := findEnvoyALProvider(root); idx < 0 && config.GlobalConfig.TelemetryMode != TelemetryModeTelemetry {
+		if err := appendMeshScalar(root, []string{"defaultProviders", "accessLogs"}, agentProviderName); err != nil {
+			return err
+		}
+		record.AddedAccessLogProvider = true
+	}
+
+	// ztunnel and waypoints only export the TCP and HTTP metrics of the Prometheus provider
+	if idx := findMetricsProvider(root, prometheusProviderName); idx < 0 && config.GlobalConfig.AmbientMode {
+		if err := appendMeshScalar(root, []string{"defaultProviders", "metrics"}, prometheusProviderName); err != nil {
+			return err
+		}
+		record.AddedMetricsProvider = true
+	}
+
+	return nil
+}
+
+// == //
+
+// findAgentProvider Function that returns the extension provider of Agent (whatever its service and port)
+func findAgentProvider(root *yaml.Node) *yaml.Node {
+	idx := findExtensionProvider(root, agentProviderName)
+	if idx < 0 {
+		return nil
+	}
+	return lookupMeshPath(root, []string{"extensionProviders"}).Content[idx]
+}
+
+// isEnvoyOtelAlCurrent Function that checks if an extension provider points at the current service and port of Agent
+func isEnvoyOtelAlCurrent(provider *yaml.Node) bool {
+	port := lookupMeshPath(provider, []string{"envoyOtelAls", "port"})
+	service := lookupMeshPath(provider, []string{"envoyOtelAls", "service"})
+
+	return port != nil && port.Value == config.GlobalConfig.CollectorPort &&
+		service != nil && service.Value == config.GlobalConfig.AgentServiceHost
+}
+
+// findExtensionProvider Function that returns the index of an extension provider by name
+func findExtensionProvider(root *yaml.Node, name string) int {
+	providers := lookupMeshPath(root, []string{"extensionProviders"})
+	if providers == nil || providers.Kind != yaml.SequenceNode {
+		return -1
+	}
+
+	for idx, provider := range providers.Content {
+		if node := lookupMeshPath(provider, []string{"name"}); node != nil && node.Value == name {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// findEnvoyALProvider Function
+func findEnvoyALProvider(root *yaml.Node) int {
+	accessLogs := lookupMeshPath(root, []string{"defaultProviders", "accessLogs"})
+	if accessLogs == nil || accessLogs.Kind != yaml.SequenceNode {
+		return -1
+	}
+
+	for idx, provider := range accessLogs.Content {
+		if provider.Value == agentProviderName {
+			return idx
+		}
+	}
+
+	return -1
 }
 
-// isEnvoyALProviderPatched Function
-func isEnvoyALProviderPatched(meshCfg meshConfig) (bool, int) {
-	for idx, accessLogProvider := range meshCfg.DefaultProviders.AccessLogs {
-		if accessLogProvider == "sentryflow-agent" {
-			return true, idx
+// findMetricsProvider Function
+func findMetricsProvider(root *yaml.Node, name string) int {
+	metrics := lookupMeshPath(root, []string{"defaultProviders", "metrics"})
+	if metrics == nil || metrics.Kind != yaml.SequenceNode {
+		return -1
+	}
+
+	for idx, provider := range metrics.Content {
+		if provider.Value == name {
+			return idx
 		}
 	}
-	return false, -1
+
+	return -1
 }
 
 // isIstioAlreadyPatched Function
-func isIstioAlreadyPatched(meshCfg meshConfig) bool {
-	if meshCfg.DefaultConfig.EnvoyAccessLogService.Address != "sentryflow-agent.sentryflow.svc.cluster.local:4317" ||
-		meshCfg.DefaultConfig.EnvoyMetricsService.Address != "sentryflow-agent.sentryflow.svc.cluster.local:4317" {
-		return false
+func isIstioAlreadyPatched(root *yaml.Node) bool {
+	for _, field := range managedMeshFields(config.GlobalConfig.TelemetryMode) {
+		node := lookupMeshPath(root, field.path)
+		if node == nil || node.Value != field.value {
+			return false
+		}
 	}
 
-	if patched, _ := isEnvoyOtelAlPatched(meshCfg); !patched {
+	if provider := findAgentProvider(root); provider == nil || !isEnvoyOtelAlCurrent(provider) {
 		return false
 	}
 
-	if patched, _ := isEnvoyALProviderPatched(meshCfg); !patched {
+	if findEnvoyALProvider(root) < 0 && config.GlobalConfig.TelemetryMode != TelemetryModeTelemetry {
 		return false
 	}
 
-	if !meshCfg.EnableEnvoyAccessLogService {
+	if findMetricsProvider(root, prometheusProviderName) < 0 && config.GlobalConfig.AmbientMode {
 		return false
 	}
 
 	return true
 }
+
+// == //
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/json"
 
@@ -16,9 +17,14 @@ import (
 	"Agent/uploader"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
@@ -26,9 +32,12 @@ import (
 
 // == //
 
-// K8sH global reference for Kubernetes Handler
+// K8sH global reference for Kubernetes Handler (the primary cluster, also used for patching)
 var K8sH *KubernetesHandler
 
+// K8sHandlers global reference for the Kubernetes Handlers of every watched cluster (K8sH first)
+var K8sHandlers []*KubernetesHandler
+
 // init Function
 func init() {
 	K8sH = NewK8sHandler()
@@ -36,15 +45,39 @@ func init() {
 
 // KubernetesHandler Structure
 type KubernetesHandler struct {
-	config    *rest.Config
-	clientSet *kubernetes.Clientset
+	contextName string // kubeconfig context (empty: in-cluster)
+	clusterName string // cluster name reported to Operator
+
+	config        *rest.Config
+	clientSet     *kubernetes.Clientset
+	dynamicClient dynamic.Interface
 
 	watchers  map[string]*cache.ListWatch
 	informers map[string]cache.Controller
+	stores    map[string]cache.Store
 
 	podMap     map[string]*corev1.Pod        // NOT thread safe, key: Pod IP
 	serviceMap map[string]*corev1.Service    // NOT thread safe, key: Service IP
 	deployMap  map[string]*appsv1.Deployment // NOT thread safe, key: Namespace/DeploymentName
+
+	statefulSetMap map[string]*appsv1.StatefulSet // NOT thread safe, key: Namespace/StatefulSetName
+	daemonSetMap   map[string]*appsv1.DaemonSet   // NOT thread safe, key: Namespace/DaemonSetName
+	jobMap         map[string]*batchv1.Job        // NOT thread safe, key: Namespace/JobName
+	cronJobMap     map[string]*batchv1.CronJob    // NOT thread safe, key: Namespace/CronJobName
+
+	nodeMap      map[string]*corev1.Node      // NOT thread safe, key: NodeName
+	namespaceMap map[string]*corev1.Namespace // NOT thread safe, key: NamespaceName
+
+	patchedNamespaces []string // namespaces patched with 'istio-injection' by Agent
+
+	ingressMap  map[string]*types.Ingress // key: Kind/Namespace/Name
+	ingressLock sync.RWMutex
+
+	endpointSliceMap    map[string]*discoveryv1.EndpointSlice // key: Namespace/EndpointSliceName
+	serviceEndpointsMap map[string]*types.ServiceEndpoints    // key: Namespace/ServiceName
+	endpointsLock       sync.Mutex
+
+	noticesSince time.Time // Events last seen before this are not forwarded
 }
 
 // NewK8sHandler Function
@@ -52,10 +85,24 @@ func NewK8sHandler() *KubernetesHandler {
 	kh := &KubernetesHandler{
 		watchers:  make(map[string]*cache.ListWatch),
 		informers: make(map[string]cache.Controller),
+		stores:    make(map[string]cache.Store),
 
 		podMap:     make(map[string]*corev1.Pod),
 		serviceMap: make(map[string]*corev1.Service),
 		deployMap:  make(map[string]*appsv1.Deployment),
+
+		statefulSetMap: make(map[string]*appsv1.StatefulSet),
+		daemonSetMap:   make(map[string]*appsv1.DaemonSet),
+		jobMap:         make(map[string]*batchv1.Job),
+		cronJobMap:     make(map[string]*batchv1.CronJob),
+
+		nodeMap:      make(map[string]*corev1.Node),
+		namespaceMap: make(map[string]*corev1.Namespace),
+
+		ingressMap: make(map[string]*types.Ingress),
+
+		endpointSliceMap:    make(map[string]*discoveryv1.EndpointSlice),
+		serviceEndpointsMap: make(map[string]*types.ServiceEndpoints),
 	}
 
 	return kh
@@ -65,60 +112,117 @@ func NewK8sHandler() *KubernetesHandler {
 
 // InitK8sClient Function
 func InitK8sClient() bool {
+	clusters, err := loadClusterConfigs()
+	if err != nil {
+		log.Printf("[InitK8sClient] Failed to load Kubernetes config: %v", err)
+		return false
+	}
+
+	handlers := make([]*KubernetesHandler, 0, len(clusters))
+
+	for idx, cluster := range clusters {
+		k8s := K8sH
+		if idx > 0 {
+			k8s = NewK8sHandler()
+		}
+
+		k8s.contextName = cluster.contextName
+		k8s.clusterName = cluster.clusterName
+		k8s.config = cluster.restConfig
+
+		if !k8s.initClient() {
+			return false
+		}
+
+		handlers = append(handlers, k8s)
+	}
+
+	K8sHandlers = handlers
+
+	return true
+}
+
+// initClient Function that initializes the clients, watchers and informers of a cluster
+func (k8s *KubernetesHandler) initClient() bool {
 	var err error
 
-	// Initialize in cluster config
-	K8sH.config, err = rest.InClusterConfig()
+	// Initialize Kubernetes clientSet
+	k8s.clientSet, err = kubernetes.NewForConfig(k8s.config)
 	if err != nil {
-		log.Print("[InitK8sClient] Failed to initialize Kubernetes client")
+		log.Printf("[InitK8sClient] Failed to initialize Kubernetes client for cluster %s", k8s.clusterName)
 		return false
 	}
 
-	// Initialize Kubernetes clientSet
-	K8sH.clientSet, err = kubernetes.NewForConfig(K8sH.config)
+	// Initialize Kubernetes dynamic client (for CRDs)
+	k8s.dynamicClient, err = dynamic.NewForConfig(k8s.config)
 	if err != nil {
-		log.Print("[InitK8sClient] Failed to initialize Kubernetes client")
+		log.Printf("[InitK8sClient] Failed to initialize Kubernetes dynamic client for cluster %s", k8s.clusterName)
 		return false
 	}
 
-	watchTargetsCoreV1 := []string{"pods", "services"}
-	watchTargetsAppsV1 := []string{"deployments"}
+	watchTargetsCoreV1 := []string{"pods", "services", "nodes", "namespaces"}
+	watchTargetsAppsV1 := []string{"deployments", "statefulsets", "daemonsets"}
+	watchTargetsBatchV1 := []string{"jobs", "cronjobs"}
 
-	//  Initialize watchers for pods and services
+	//  Initialize watchers for pods, services, nodes and namespaces
 	for _, target := range watchTargetsCoreV1 {
 		watcher := cache.NewListWatchFromClient(
-			K8sH.clientSet.CoreV1().RESTClient(),
+			k8s.clientSet.CoreV1().RESTClient(),
 			target,
 			corev1.NamespaceAll,
 			fields.Everything(),
 		)
-		K8sH.watchers[target] = watcher
+		k8s.watchers[target] = watcher
 	}
 
-	// Initialize watchers for deployments
+	// Initialize watchers for deployments, statefulsets and daemonsets
 	for _, target := range watchTargetsAppsV1 {
 		watcher := cache.NewListWatchFromClient(
-			K8sH.clientSet.AppsV1().RESTClient(),
+			k8s.clientSet.AppsV1().RESTClient(),
 			target,
 			corev1.NamespaceAll,
 			fields.Everything(),
 		)
-		K8sH.watchers[target] = watcher
+		k8s.watchers[target] = watcher
 	}
 
+	// Initialize watchers for jobs and cronjobs
+	for _, target := range watchTargetsBatchV1 {
+		watcher := cache.NewListWatchFromClient(
+			k8s.clientSet.BatchV1().RESTClient(),
+			target,
+			corev1.NamespaceAll,
+			fields.Everything(),
+		)
+		k8s.watchers[target] = watcher
+	}
+
+	// Initialize watchers for ingresses, gateways and routes
+	k8s.initIngressWatchers()
+
+	// Initialize watcher for endpointslices
+	k8s.initEndpointSliceWatchers()
+
+	// Initialize watcher for events
+	k8s.initEventWatchers()
+
 	// Initialize informers
-	K8sH.initInformers()
+	k8s.initInformers()
 
-	log.Print("[InitK8sClient] Initialized Kubernetes client")
+	if k8s.contextName != "" {
+		log.Printf("[InitK8sClient] Initialized Kubernetes client for cluster %s (context %s)", k8s.clusterName, k8s.contextName)
+	} else {
+		log.Printf("[InitK8sClient] Initialized Kubernetes client for cluster %s (in-cluster)", k8s.clusterName)
+	}
 
 	return true
 }
 
-// initInformers Function that initializes informers for services and pods in a cluster
+// initInformers Function that initializes informers for workloads, services and pods in a cluster
 func (k8s *KubernetesHandler) initInformers() {
 	// Create Pod controller informer
 	if k8s.watchers["pods"] != nil {
-		_, podController := cache.NewInformerWithOptions(
+		podStore, podController := cache.NewInformerWithOptions(
 			cache.InformerOptions{
 				ListerWatcher: k8s.watchers["pods"],
 				ObjectType:    &corev1.Pod{},
@@ -130,7 +234,7 @@ func (k8s *KubernetesHandler) initInformers() {
 						if ip != "" {
 							k8s.podMap[ip] = pod
 							log.Printf("[Informer:Pod] ADDED Pod %s/%s, IP=%s", pod.Namespace, pod.Name, ip)
-							go uploader.UplH.UploadClusterEvent("Pod", "ADD", pod)
+							go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Pod", "ADD", pod)
 						}
 					},
 					UpdateFunc: func(oldObj, newObj interface{}) {
@@ -139,7 +243,7 @@ func (k8s *KubernetesHandler) initInformers() {
 						if ip != "" {
 							k8s.podMap[ip] = newPod
 							log.Printf("[Informer:Pod] UPDATED Pod %s/%s, IP=%s", newPod.Namespace, newPod.Name, ip)
-							go uploader.UplH.UploadClusterEvent("Pod", "UPDATE", newPod)
+							go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Pod", "UPDATE", newPod)
 						}
 					},
 					DeleteFunc: func(obj interface{}) {
@@ -148,18 +252,19 @@ func (k8s *KubernetesHandler) initInformers() {
 						if ip != "" {
 							delete(k8s.podMap, ip)
 							log.Printf("[Informer:Pod] DELETED Pod %s/%s, IP=%s", pod.Namespace, pod.Name, ip)
-							go uploader.UplH.UploadClusterEvent("Pod", "DELETE", pod)
+							go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Pod", "DELETE", pod)
 						}
 					},
 				},
 			},
 		)
 		k8s.informers["pods"] = podController
+		k8s.stores["pods"] = podStore
 	}
 
 	// Create Service controller informer
 	if k8s.watchers["services"] != nil {
-		_, svcController := cache.NewInformerWithOptions(
+		svcStore, svcController := cache.NewInformerWithOptions(
 			cache.InformerOptions{
 				ListerWatcher: k8s.watchers["services"],
 				ObjectType:    &corev1.Service{},
@@ -169,7 +274,7 @@ func (k8s *KubernetesHandler) initInformers() {
 						svc := obj.(*corev1.Service)
 						k8s.addOrUpdateServiceIPs(svc)
 						log.Printf("[Informer:Service] ADDED Service %s/%s", svc.Namespace, svc.Name)
-						go uploader.UplH.UploadClusterEvent("Service", "ADD", svc)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Service", "ADD", svc)
 					},
 					UpdateFunc: func(oldObj, newObj interface{}) {
 						oldSvc := oldObj.(*corev1.Service)
@@ -177,23 +282,24 @@ func (k8s *KubernetesHandler) initInformers() {
 						k8s.removeServiceIPs(oldSvc)
 						k8s.addOrUpdateServiceIPs(newSvc)
 						log.Printf("[Informer:Service] UPDATED Service %s/%s", newSvc.Namespace, newSvc.Name)
-						go uploader.UplH.UploadClusterEvent("Service", "UPDATE", newSvc)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Service", "UPDATE", newSvc)
 					},
 					DeleteFunc: func(obj interface{}) {
 						svc := obj.(*corev1.Service)
 						k8s.removeServiceIPs(svc)
 						log.Printf("[Informer:Service] DELETED Service %s/%s", svc.Namespace, svc.Name)
-						go uploader.UplH.UploadClusterEvent("Service", "DELETE", svc)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Service", "DELETE", svc)
 					},
 				},
 			},
 		)
 		k8s.informers["services"] = svcController
+		k8s.stores["services"] = svcStore
 	}
 
 	// Create Deployment controller informer
 	if k8s.watchers["deployments"] != nil {
-		_, depController := cache.NewInformerWithOptions(
+		depStore, depController := cache.NewInformerWithOptions(
 			cache.InformerOptions{
 				ListerWatcher: k8s.watchers["deployments"],
 				ObjectType:    &appsv1.Deployment{},
@@ -204,27 +310,247 @@ func (k8s *KubernetesHandler) initInformers() {
 						key := fmt.Sprintf("%s/%s", dep.Namespace, dep.Name)
 						k8s.deployMap[key] = dep
 						log.Printf("[Informer:Deploy] ADDED Deployment %s", key)
-						go uploader.UplH.UploadClusterEvent("Deploy", "ADD", dep)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Deploy", "ADD", dep)
 					},
 					UpdateFunc: func(oldObj, newObj interface{}) {
 						dep := newObj.(*appsv1.Deployment)
 						key := fmt.Sprintf("%s/%s", dep.Namespace, dep.Name)
 						k8s.deployMap[key] = dep
 						log.Printf("[Informer:Deploy] UPDATED Deployment %s", key)
-						go uploader.UplH.UploadClusterEvent("Deploy", "UPDATE", dep)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Deploy", "UPDATE", dep)
 					},
 					DeleteFunc: func(obj interface{}) {
 						dep := obj.(*appsv1.Deployment)
 						key := fmt.Sprintf("%s/%s", dep.Namespace, dep.Name)
 						delete(k8s.deployMap, key)
 						log.Printf("[Informer:Deploy] DELETED Deployment %s", key)
-						go uploader.UplH.UploadClusterEvent("Deploy", "DELETE", dep)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Deploy", "DELETE", dep)
 					},
 				},
 			},
 		)
 		k8s.informers["deployments"] = depController
+		k8s.stores["deployments"] = depStore
+	}
+
+	// Create StatefulSet controller informer
+	if k8s.watchers["statefulsets"] != nil {
+		stsStore, stsController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["statefulsets"],
+				ObjectType:    &appsv1.StatefulSet{},
+				ResyncPeriod:  0,
+				Handler: cache.ResourceEventHandlerFuncs{
+					AddFunc: func(obj interface{}) {
+						sts := obj.(*appsv1.StatefulSet)
+						key := fmt.Sprintf("%s/%s", sts.Namespace, sts.Name)
+						k8s.statefulSetMap[key] = sts
+						log.Printf("[Informer:StatefulSet] ADDED StatefulSet %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "StatefulSet", "ADD", sts)
+					},
+					UpdateFunc: func(oldObj, newObj interface{}) {
+						sts := newObj.(*appsv1.StatefulSet)
+						key := fmt.Sprintf("%s/%s", sts.Namespace, sts.Name)
+						k8s.statefulSetMap[key] = sts
+						log.Printf("[Informer:StatefulSet] UPDATED StatefulSet %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "StatefulSet", "UPDATE", sts)
+					},
+					DeleteFunc: func(obj interface{}) {
+						sts := obj.(*appsv1.StatefulSet)
+						key := fmt.Sprintf("%s/%s", sts.Namespace, sts.Name)
+						delete(k8s.statefulSetMap, key)
+						log.Printf("[Informer:StatefulSet] DELETED StatefulSet %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "StatefulSet", "DELETE", sts)
+					},
+				},
+			},
+		)
+		k8s.informers["statefulsets"] = stsController
+		k8s.stores["statefulsets"] = stsStore
+	}
+
+	// Create DaemonSet controller informer
+	if k8s.watchers["daemonsets"] != nil {
+		dsStore, dsController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["daemonsets"],
+				ObjectType:    &appsv1.DaemonSet{},
+				ResyncPeriod:  0,
+				Handler: cache.ResourceEventHandlerFuncs{
+					AddFunc: func(obj interface{}) {
+						ds := obj.(*appsv1.DaemonSet)
+						key := fmt.Sprintf("%s/%s", ds.Namespace, ds.Name)
+						k8s.daemonSetMap[key] = ds
+						log.Printf("[Informer:DaemonSet] ADDED DaemonSet %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "DaemonSet", "ADD", ds)
+					},
+					UpdateFunc: func(oldObj, newObj interface{}) {
+						ds := newObj.(*appsv1.DaemonSet)
+						key := fmt.Sprintf("%s/%s", ds.Namespace, ds.Name)
+						k8s.daemonSetMap[key] = ds
+						log.Printf("[Informer:DaemonSet] UPDATED DaemonSet %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "DaemonSet", "UPDATE", ds)
+					},
+					DeleteFunc: func(obj interface{}) {
+						ds := obj.(*appsv1.DaemonSet)
+						key := fmt.Sprintf("%s/%s", ds.Namespace, ds.Name)
+						delete(k8s.daemonSetMap, key)
+						log.Printf("[Informer:DaemonSet] DELETED DaemonSet %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "DaemonSet", "DELETE", ds)
+					},
+				},
+			},
+		)
+		k8s.informers["daemonsets"] = dsController
+		k8s.stores["daemonsets"] = dsStore
+	}
+
+	// Create Job controller informer
+	if k8s.watchers["jobs"] != nil {
+		jobStore, jobController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["jobs"],
+				ObjectType:    &batchv1.Job{},
+				ResyncPeriod:  0,
+				Handler: cache.ResourceEventHandlerFuncs{
+					AddFunc: func(obj interface{}) {
+						job := obj.(*batchv1.Job)
+						key := fmt.Sprintf("%s/%s", job.Namespace, job.Name)
+						k8s.jobMap[key] = job
+						log.Printf("[Informer:Job] ADDED Job %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Job", "ADD", job)
+					},
+					UpdateFunc: func(oldObj, newObj interface{}) {
+						job := newObj.(*batchv1.Job)
+						key := fmt.Sprintf("%s/%s", job.Namespace, job.Name)
+						k8s.jobMap[key] = job
+						log.Printf("[Informer:Job] UPDATED Job %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Job", "UPDATE", job)
+					},
+					DeleteFunc: func(obj interface{}) {
+						job := obj.(*batchv1.Job)
+						key := fmt.Sprintf("%s/%s", job.Namespace, job.Name)
+						delete(k8s.jobMap, key)
+						log.Printf("[Informer:Job] DELETED Job %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Job", "DELETE", job)
+					},
+				},
+			},
+		)
+		k8s.informers["jobs"] = jobController
+		k8s.stores["jobs"] = jobStore
+	}
+
+	// Create CronJob controller informer
+	if k8s.watchers["cronjobs"] != nil {
+		cjStore, cjController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["cronjobs"],
+				ObjectType:    &batchv1.CronJob{},
+				ResyncPeriod:  0,
+				Handler: cache.ResourceEventHandlerFuncs{
+					AddFunc: func(obj interface{}) {
+						cj := obj.(*batchv1.CronJob)
+						key := fmt.Sprintf("%s/%s", cj.Namespace, cj.Name)
+						k8s.cronJobMap[key] = cj
+						log.Printf("[Informer:CronJob] ADDED CronJob %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "CronJob", "ADD", cj)
+					},
+					UpdateFunc: func(oldObj, newObj interface{}) {
+						cj := newObj.(*batchv1.CronJob)
+						key := fmt.Sprintf("%s/%s", cj.Namespace, cj.Name)
+						k8s.cronJobMap[key] = cj
+						log.Printf("[Informer:CronJob] UPDATED CronJob %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "CronJob", "UPDATE", cj)
+					},
+					DeleteFunc: func(obj interface{}) {
+						cj := obj.(*batchv1.CronJob)
+						key := fmt.Sprintf("%s/%s", cj.Namespace, cj.Name)
+						delete(k8s.cronJobMap, key)
+						log.Printf("[Informer:CronJob] DELETED CronJob %s", key)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "CronJob", "DELETE", cj)
+					},
+				},
+			},
+		)
+		k8s.informers["cronjobs"] = cjController
+		k8s.stores["cronjobs"] = cjStore
+	}
+
+	// Create Node controller informer
+	if k8s.watchers["nodes"] != nil {
+		nodeStore, nodeController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["nodes"],
+				ObjectType:    &corev1.Node{},
+				ResyncPeriod:  0,
+				Handler: cache.ResourceEventHandlerFuncs{
+					AddFunc: func(obj interface{}) {
+						node := obj.(*corev1.Node)
+						k8s.nodeMap[node.Name] = node
+						log.Printf("[Informer:Node] ADDED Node %s", node.Name)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Node", "ADD", node)
+					},
+					UpdateFunc: func(oldObj, newObj interface{}) {
+						node := newObj.(*corev1.Node)
+						k8s.nodeMap[node.Name] = node
+						log.Printf("[Informer:Node] UPDATED Node %s", node.Name)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Node", "UPDATE", node)
+					},
+					DeleteFunc: func(obj interface{}) {
+						node := obj.(*corev1.Node)
+						delete(k8s.nodeMap, node.Name)
+						log.Printf("[Informer:Node] DELETED Node %s", node.Name)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Node", "DELETE", node)
+					},
+				},
+			},
+		)
+		k8s.informers["nodes"] = nodeController
+		k8s.stores["nodes"] = nodeStore
 	}
+
+	// Create Namespace controller informer
+	if k8s.watchers["namespaces"] != nil {
+		nsStore, nsController := cache.NewInformerWithOptions(
+			cache.InformerOptions{
+				ListerWatcher: k8s.watchers["namespaces"],
+				ObjectType:    &corev1.Namespace{},
+				ResyncPeriod:  0,
+				Handler: cache.ResourceEventHandlerFuncs{
+					AddFunc: func(obj interface{}) {
+						ns := obj.(*corev1.Namespace)
+						k8s.namespaceMap[ns.Name] = ns
+						log.Printf("[Informer:Namespace] ADDED Namespace %s", ns.Name)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Namespace", "ADD", ns)
+					},
+					UpdateFunc: func(oldObj, newObj interface{}) {
+						ns := newObj.(*corev1.Namespace)
+						k8s.namespaceMap[ns.Name] = ns
+						log.Printf("[Informer:Namespace] UPDATED Namespace %s", ns.Name)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Namespace", "UPDATE", ns)
+					},
+					DeleteFunc: func(obj interface{}) {
+						ns := obj.(*corev1.Namespace)
+						delete(k8s.namespaceMap, ns.Name)
+						log.Printf("[Informer:Namespace] DELETED Namespace %s", ns.Name)
+						go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Namespace", "DELETE", ns)
+					},
+				},
+			},
+		)
+		k8s.informers["namespaces"] = nsController
+		k8s.stores["namespaces"] = nsStore
+	}
+
+	// Create Ingress, Gateway and Route controller informers
+	k8s.initIngressInformers()
+
+	// Create EndpointSlice controller informer
+	k8s.initEndpointSliceInformers()
+
+	// Create Event controller informer
+	k8s.initEventInformers()
 }
 
 // addOrUpdateServiceIPs Function
@@ -273,11 +599,13 @@ func RunInformers(stopChan chan struct{}, wg *sync.WaitGroup) {
 
 	go func() {
 		defer wg.Done()
-		for name, informer := range K8sH.informers {
-			go func(name string, inf cache.Controller) {
-				log.Printf("[RunInformers] Starting informer for %s", name)
-				inf.Run(stopChan)
-			}(name, informer)
+		for _, k8s := range K8sHandlers {
+			for name, informer := range k8s.informers {
+				go func(cluster, name string, inf cache.Controller) {
+					log.Printf("[RunInformers] Starting informer for %s in cluster %s", name, cluster)
+					inf.Run(stopChan)
+				}(k8s.clusterName, name, informer)
+			}
 		}
 		<-stopChan
 		log.Print("[RunInformers] Stop signal received. All informers stopping.")
@@ -287,125 +615,238 @@ func RunInformers(stopChan chan struct{}, wg *sync.WaitGroup) {
 }
 
 // getConfigMap Function
-func (k8s *KubernetesHandler) getConfigMap(namespace, name string) (string, error) {
+func (k8s *KubernetesHandler) getConfigMap(namespace, name string) (*corev1.ConfigMap, error) {
 	cm, err := k8s.clientSet.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, v1.GetOptions{})
 	if err != nil {
 		log.Printf("[K8s] Failed to get ConfigMaps: %v", err)
-		return "", err
+		return nil, err
 	}
 
-	// convert data to string
-	data, err := json.Marshal(cm.Data)
-	if err != nil {
-		log.Printf("[K8s] Failed to marshal ConfigMap: %v", err)
-		return "", err
-	}
-
-	return string(data), nil
+	return cm, nil
 }
 
 // updateConfigMap Function
-func (k8s *KubernetesHandler) updateConfigMap(namespace, name, data string) error {
-	cm, err := k8s.clientSet.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, v1.GetOptions{})
-	if err != nil {
-		log.Printf("[K8s] Failed to get ConfigMap: %v", err)
-		return err
-	}
-
+func (k8s *KubernetesHandler) updateConfigMap(cm *corev1.ConfigMap) error {
 	if _, ok := cm.Data["mesh"]; !ok {
 		return errors.New("[K8s] Unable to find field \"mesh\" from Istio config")
 	}
 
-	cm.Data["mesh"] = data
-	if _, err := k8s.clientSet.CoreV1().ConfigMaps(namespace).Update(context.Background(), cm, v1.UpdateOptions{}); err != nil {
+	// Update with the fetched resourceVersion, so concurrent changes are not overwritten
+	if _, err := k8s.clientSet.CoreV1().ConfigMaps(cm.Namespace).Update(context.Background(), cm, v1.UpdateOptions{}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// PatchNamespaces Function that patches namespaces for adding 'istio-injection'
+// PatchNamespaces Function that patches the selected namespaces for adding 'istio-injection'
 func PatchNamespaces() bool {
-	namespaces, err := K8sH.clientSet.CoreV1().Namespaces().List(context.Background(), v1.ListOptions{})
+	include, err := labels.Parse(config.GlobalConfig.PatchingIncludeSelector)
+	if err != nil {
+		log.Printf("[PatchNamespaces] Invalid include selector %q: %v", config.GlobalConfig.PatchingIncludeSelector, err)
+		return false
+	}
+
+	exclude := labels.Nothing()
+	if config.GlobalConfig.PatchingExcludeSelector != "" {
+		exclude, err = labels.Parse(config.GlobalConfig.PatchingExcludeSelector)
+		if err != nil {
+			log.Printf("[PatchNamespaces] Invalid exclude selector %q: %v", config.GlobalConfig.PatchingExcludeSelector, err)
+			return false
+		}
+	}
+
+	namespaces, err := K8sH.clientSet.CoreV1().Namespaces().List(context.Background(), v1.ListOptions{LabelSelector: include.String()})
 	if err != nil {
 		log.Printf("[PatchNamespaces] Failed to get Namespaces: %v", err)
 		return false
 	}
 
+	dryRun := config.GlobalConfig.PatchingDryRun
+	K8sH.patchedNamespaces = nil
+
 	for _, ns := range namespaces.Items {
-		namespace := ns.DeepCopy()
+		if exclude.Matches(labels.Set(ns.Labels)) {
+			log.Printf("[PatchNamespaces] Skipped Namespace %s (excluded)", ns.Name)
+			continue
+		}
 
-		// By default, we want to patch the following namespaces
-		// namespace.Name == "sentryflow" => for multicluster networking
+		// Respect namespaces that explicitly opted out of injection
+		if ns.Labels[types.LabelIstioInjection] == "disabled" {
+			log.Printf("[PatchNamespaces] Skipped Namespace %s (injection disabled)", ns.Name)
+			continue
+		}
 
-		// If don't want to patch the "default" namespace, follwing code can be used
-		// if namespace.Name == "defualt" {
-		// 	continue
-		// }
+		// Already part of the mesh (injection, revision or ambient), nothing to change
+		if types.IsNamespaceMeshed(ns.Labels) {
+			log.Printf("[PatchNamespaces] Skipped Namespace %s (already meshed)", ns.Name)
+			continue
+		}
 
-		namespace.Labels["istio-injection"] = "enabled"
+		// Do not overwrite the original labels recorded by a previous run
+		original := ns.Annotations[types.AnnotationOriginalLabels]
+		if original == "" {
+			recorded := map[string]*string{types.LabelIstioInjection: nil}
+			if value, ok := ns.Labels[types.LabelIstioInjection]; ok {
+				recorded[types.LabelIstioInjection] = &value
+			}
+			data, err := json.Marshal(recorded)
+			if err != nil {
+				log.Printf("[PatchNamespaces] Failed to record labels of Namespace %s: %v", ns.Name, err)
+				return false
+			}
+			original = string(data)
+		}
 
-		// Patch the namespace
-		if _, err := K8sH.clientSet.CoreV1().Namespaces().Update(context.TODO(), namespace, v1.UpdateOptions{FieldManager: "patcher"}); err != nil {
-			log.Printf("[PatchNamespaces] Failed to update Namespace %s: %v", namespace.Name, err)
+		patch := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels":      map[string]string{types.LabelIstioInjection: "enabled"},
+				"annotations": map[string]string{types.AnnotationOriginalLabels: original},
+			},
+		}
+
+		if err := K8sH.patchNamespace(ns.Name, patch, dryRun); err != nil {
+			log.Printf("[PatchNamespaces] Failed to patch Namespace %s: %v", ns.Name, err)
 			return false
 		}
+		K8sH.patchedNamespaces = append(K8sH.patchedNamespaces, ns.Name)
 
-		log.Printf("[PatchNamespaces] Updated Namespace %s", namespace.Name)
+		if dryRun {
+			log.Printf("[PatchNamespaces] (dry-run) Would set %s=enabled on Namespace %s (was %q)",
+				types.LabelIstioInjection, ns.Name, ns.Labels[types.LabelIstioInjection])
+		} else {
+			log.Printf("[PatchNamespaces] Updated Namespace %s", ns.Name)
+		}
 	}
 
-	log.Print("[PatchNamespaces] Updated all Namespaces")
+	log.Printf("[PatchNamespaces] Updated %d Namespaces", len(K8sH.patchedNamespaces))
 
 	return true
 }
 
-// restartDeployment Function that performs a rolling restart for a deployment in the specified namespace
-// @todo: fix this, this DOES NOT restart deployments
-func (k8s *KubernetesHandler) restartDeployment(namespace string, deploymentName string) error {
-	deploymentClient := k8s.clientSet.AppsV1().Deployments(namespace)
+// UnpatchNamespaces Function that restores the labels recorded when namespaces were patched
+func UnpatchNamespaces() bool {
+	if K8sH.clientSet == nil {
+		return false
+	}
 
-	// Get the deployment to retrieve the current spec
-	deployment, err := deploymentClient.Get(context.Background(), deploymentName, v1.GetOptions{})
+	namespaces, err := K8sH.clientSet.CoreV1().Namespaces().List(context.Background(), v1.ListOptions{})
 	if err != nil {
-		return err
+		log.Printf("[UnpatchNamespaces] Failed to get Namespaces: %v", err)
+		return false
+	}
+
+	dryRun := config.GlobalConfig.PatchingDryRun
+
+	for _, ns := range namespaces.Items {
+		original, ok := ns.Annotations[types.AnnotationOriginalLabels]
+		if !ok {
+			continue // not patched by Agent
+		}
+
+		recorded := map[string]*string{}
+		if err := json.Unmarshal([]byte(original), &recorded); err != nil {
+			log.Printf("[UnpatchNamespaces] Failed to read recorded labels of Namespace %s: %v", ns.Name, err)
+			continue
+		}
+
+		// A nil value removes a label that did not exist before patching
+		patch := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels":      recorded,
+				"annotations": map[string]*string{types.AnnotationOriginalLabels: nil},
+			},
+		}
+
+		if err := K8sH.patchNamespace(ns.Name, patch, dryRun); err != nil {
+			log.Printf("[UnpatchNamespaces] Failed to unpatch Namespace %s: %v", ns.Name, err)
+			return false
+		}
+
+		if dryRun {
+			log.Printf("[UnpatchNamespaces] (dry-run) Would restore labels %s on Namespace %s", original, ns.Name)
+		} else {
+			log.Printf("[UnpatchNamespaces] Restored Namespace %s", ns.Name)
+		}
 	}
 
-	// Trigger a rolling restart by updating the deployment's labels or annotations
-	deployment.Spec.Template.ObjectMeta.Labels["restartedAt"] = v1.Now().String()
+	log.Print("[UnpatchNamespaces] Restored all patched Namespaces")
 
-	// Update the deployment to trigger the rolling restart
-	_, err = deploymentClient.Update(context.TODO(), deployment, v1.UpdateOptions{})
+	return true
+}
+
+// patchNamespace Function that applies a JSON merge patch to a namespace
+func (k8s *KubernetesHandler) patchNamespace(name string, patch map[string]interface{}, dryRun bool) error {
+	data, err := json.Marshal(patch)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	opts := v1.PatchOptions{FieldManager: "patcher"}
+	if dryRun {
+		opts.DryRun = []string{v1.DryRunAll}
+	}
+
+	_, err = k8s.clientSet.CoreV1().Namespaces().Patch(context.Background(), name, k8stypes.MergePatchType, data, opts)
+	return err
 }
 
-// RestartDeployments Function that restarts the deployments in the namespaces with "istio-injection=enabled"
-func RestartDeployments() bool {
-	deployments, err := K8sH.clientSet.AppsV1().Deployments("").List(context.Background(), v1.ListOptions{})
-	if err != nil {
-		log.Printf("[PatchDeployments] Failed to get Deployments: %v", err)
-		return false
+// restartDeployment Function that performs a rolling restart for a deployment in the specified namespace
+func (k8s *KubernetesHandler) restartDeployment(namespace string, deploymentName string, dryRun bool) error {
+	// Same as 'kubectl rollout restart', which updates the pod template annotation
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"%s":"%s"}}}}}`,
+		types.AnnotationRestartedAt, time.Now().Format(time.RFC3339))
+
+	opts := v1.PatchOptions{FieldManager: "patcher"}
+	if dryRun {
+		opts.DryRun = []string{v1.DryRunAll}
 	}
 
-	for _, deployment := range deployments.Items {
+	_, err := k8s.clientSet.AppsV1().Deployments(namespace).Patch(context.Background(), deploymentName, k8stypes.StrategicMergePatchType, []byte(patch), opts)
+	return err
+}
+
+// RestartDeployments Function that restarts the deployments in the namespaces patched by PatchNamespaces
+func RestartDeployments() bool {
+	dryRun := config.GlobalConfig.PatchingDryRun
+	interval := time.Duration(config.GlobalConfig.RestartInterval) * time.Second
+
+	restarted := 0
+
+	for _, namespace := range K8sH.patchedNamespaces {
 		// Skip the following namespaces
-		if deployment.Namespace == "sentryflow" {
+		if namespace == "sentryflow" {
 			continue
 		}
 
-		// Restart the deployment
-		if err := K8sH.restartDeployment(deployment.Namespace, deployment.Name); err != nil {
-			log.Printf("[PatchDeployments] Failed to restart Deployment %s/%s: %v", deployment.Namespace, deployment.Name, err)
+		deployments, err := K8sH.clientSet.AppsV1().Deployments(namespace).List(context.Background(), v1.ListOptions{})
+		if err != nil {
+			log.Printf("[PatchDeployments] Failed to get Deployments in %s: %v", namespace, err)
 			return false
 		}
 
-		log.Printf("[PatchDeployments] Deployment %s/%s restarted", deployment.Namespace, deployment.Name)
+		for _, deployment := range deployments.Items {
+			// Rate limit rollouts so that not every workload restarts at once
+			if restarted > 0 && !dryRun && interval > 0 {
+				time.Sleep(interval)
+			}
+
+			// Restart the deployment
+			if err := K8sH.restartDeployment(deployment.Namespace, deployment.Name, dryRun); err != nil {
+				log.Printf("[PatchDeployments] Failed to restart Deployment %s/%s: %v", deployment.Namespace, deployment.Name, err)
+				return false
+			}
+			restarted++
+
+			if dryRun {
+				log.Printf("[PatchDeployments] (dry-run) Would restart Deployment %s/%s", deployment.Namespace, deployment.Name)
+			} else {
+				log.Printf("[PatchDeployments] Deployment %s/%s restarted", deployment.Namespace, deployment.Name)
+			}
+		}
 	}
 
-	log.Print("[PatchDeployments] Restarted all patched deployments")
+	log.Printf("[PatchDeployments] Restarted %d deployments in patched namespaces", restarted)
 
 	return true
 }
@@ -413,15 +854,15 @@ func RestartDeployments() bool {
 // == //
 
 // lookupIPAddress Function
-func lookupIPAddress(ipAddr string) interface{} {
+func (k8s *KubernetesHandler) lookupIPAddress(ipAddr string) interface{} {
 	// Look for pod map
-	pod, ok := K8sH.podMap[ipAddr]
+	pod, ok := k8s.podMap[ipAddr]
 	if ok {
 		return pod
 	}
 
 	// Look for service map
-	service, ok := K8sH.serviceMap[ipAddr]
+	service, ok := k8s.serviceMap[ipAddr]
 	if ok {
 		return service
 	}
@@ -429,6 +870,15 @@ func lookupIPAddress(ipAddr string) interface{} {
 	return nil
 }
 
+// lookupNodeZone Function
+func (k8s *KubernetesHandler) lookupNodeZone(nodeName string) string {
+	node, ok := k8s.nodeMap[nodeName]
+	if !ok {
+		return ""
+	}
+	return types.LookupLabel(node.Labels, types.LabelTopologyZone, types.LabelBetaTopologyZone)
+}
+
 // LookupK8sResource Function
 func LookupK8sResource(srcIP string) types.K8sResource {
 	ret := types.K8sResource{
@@ -439,24 +889,31 @@ func LookupK8sResource(srcIP string) types.K8sResource {
 		Type:      types.K8sResourceTypeUnknown,
 	}
 
-	// Find Kubernetes resource from source IP (service or a pod)
-	raw := lookupIPAddress(srcIP)
+	// Find Kubernetes resource from source IP (service or a pod), the primary cluster first
+	var raw interface{}
+	var k8s *KubernetesHandler
+	for _, k8s = range K8sHandlers {
+		if raw = k8s.lookupIPAddress(srcIP); raw != nil {
+			break
+		}
+	}
 
 	// Currently supports Service or Pod
 	switch raw.(type) {
 	case *corev1.Pod:
 		pod, ok := raw.(*corev1.Pod)
 		if ok {
-			ret.Cluster = config.GlobalConfig.ClusterName
+			ret.Cluster = k8s.clusterName
 			ret.Namespace = pod.Namespace
 			ret.Name = pod.Name
 			ret.Labels = pod.Labels
 			ret.Type = types.K8sResourceTypePod
+			ret.Zone = k8s.lookupNodeZone(pod.Spec.NodeName)
 		}
 	case *corev1.Service:
 		svc, ok := raw.(*corev1.Service)
 		if ok {
-			ret.Cluster = config.GlobalConfig.ClusterName
+			ret.Cluster = k8s.clusterName
 			ret.Namespace = svc.Namespace
 			ret.Name = svc.Name
 			ret.Labels = svc.Labels
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"Agent/config"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// == //
+
+// clusterConfig structure that describes how to reach a watched cluster
+type clusterConfig struct {
+	contextName string
+	clusterName string
+	restConfig  *rest.Config
+}
+
+// kubeContexts Function that returns the kubeconfig contexts given to Agent
+func kubeContexts() []string {
+	contexts := []string{}
+	for _, ctx := range strings.Split(config.GlobalConfig.KubeContexts, ",") {
+		if ctx = strings.TrimSpace(ctx); ctx != "" {
+			contexts = append(contexts, ctx)
+		}
+	}
+	return contexts
+}
+
+// loadClusterConfigs Function that returns the clusters to watch
+// (in-cluster config when running in a pod, otherwise one cluster per kubeconfig context)
+func loadClusterConfigs() ([]clusterConfig, error) {
+	contexts := kubeContexts()
+
+	if config.GlobalConfig.Kubeconfig == "" && len(contexts) == 0 {
+		restConfig, err := rest.InClusterConfig()
+		if err == nil {
+			return []clusterConfig{{clusterName: config.GlobalConfig.ClusterName, restConfig: restConfig}}, nil
+		}
+		log.Printf("[InitK8sClient] Not running in a cluster (%v), trying the default kubeconfig", err)
+	}
+
+	// KUBECONFIG and ~/.kube/config are used unless a path is given
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if config.GlobalConfig.Kubeconfig != "" {
+		loadingRules.ExplicitPath = config.GlobalConfig.Kubeconfig
+	}
+
+	if len(contexts) == 0 {
+		rawConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).RawConfig()
+		if err != nil {
+			return nil, err
+		}
+		if rawConfig.CurrentContext == "" {
+			return nil, errors.New("no current context in kubeconfig")
+		}
+		contexts = append(contexts, rawConfig.CurrentContext)
+	}
+
+	clusters := make([]clusterConfig, 0, len(contexts))
+	seen := make(map[string]bool)
+
+	for _, ctx := range contexts {
+		overrides := &clientcmd.ConfigOverrides{CurrentContext: ctx}
+		restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("context %s: %v", ctx, err)
+		}
+
+		// A single cluster keeps the configured name, otherwise each cluster is named after its context
+		clusterName := ctx
+		if len(contexts) == 1 && config.GlobalConfig.ClusterName != config.DefaultClusterName {
+			clusterName = config.GlobalConfig.ClusterName
+		}
+
+		if seen[clusterName] {
+			return nil, fmt.Errorf("context %s is given more than once", ctx)
+		}
+		seen[clusterName] = true
+
+		clusters = append(clusters, clusterConfig{contextName: ctx, clusterName: clusterName, restConfig: restConfig})
+	}
+
+	return clusters, nil
+}
+
+// == //
+
+// MultipleClusters Function that checks if Agent is given more than one cluster to watch
+func MultipleClusters() bool {
+	return len(kubeContexts()) > 1
+}
+
+// ClusterNames Function that returns the names of the watched clusters (the first one is the primary)
+func ClusterNames() []string {
+	names := make([]string, 0, len(K8sHandlers))
+	for _, k8s := range K8sHandlers {
+		names = append(names, k8s.clusterName)
+	}
+	return names
+}
+
+// SingleClusterName Function that returns the name of the watched cluster when Agent watches only one
+// (telemetry that does not carry its cluster, such as audit events, can only be tied to a cluster then)
+func SingleClusterName() (string, bool) {
+	if MultipleClusters() {
+		return "", false
+	}
+	if len(K8sHandlers) == 1 {
+		return K8sHandlers[0].clusterName, true
+	}
+	return config.GlobalConfig.ClusterName, true
+}
+
+// CheckClusterFeatures Function that refuses the features that only work on a single cluster
+// when Agent is given several (the audit webhook, Hubble, patching namespaces and uninstalling)
+func CheckClusterFeatures() error {
+	if !MultipleClusters() {
+		return nil
+	}
+
+	features := []struct {
+		name    string
+		enabled bool
+	}{
+		{config.AuditWebhook, config.GlobalConfig.AuditWebhook},
+		{config.HubbleEnabled, config.GlobalConfig.HubbleEnabled},
+		{config.PatchingNamespaces, config.GlobalConfig.PatchingNamespaces},
+		{config.RestartingPatchedDeployments, config.GlobalConfig.RestartingPatchedDeployments},
+		{config.Uninstall, config.GlobalConfig.Uninstall},
+	}
+
+	enabled := []string{}
+	for _, feature := range features {
+		if feature.enabled {
+			enabled = append(enabled, feature.name)
+		}
+	}
+
+	if len(enabled) > 0 {
+		return fmt.Errorf("%s cannot be used with more than one kube context", strings.Join(enabled, ", "))
+	}
+	return nil
+}
+
+// == //
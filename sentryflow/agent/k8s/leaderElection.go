@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"Agent/config"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// == //
+
+// serviceAccountNamespaceFile holds the namespace of the pod running Agent
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// podNamespace Function that returns the namespace of the pod running Agent
+func podNamespace() string {
+	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
+		return ns
+	}
+
+	if data, err := os.ReadFile(serviceAccountNamespaceFile); err == nil {
+		if ns := strings.TrimSpace(string(data)); ns != "" {
+			return ns
+		}
+	}
+
+	return "sentryflow"
+}
+
+// leaderElectionNamespace Function that returns where the leader Lease is kept
+func leaderElectionNamespace() string {
+	if ns := config.GlobalConfig.LeaderElectionNamespace; ns != "" {
+		return ns
+	}
+	return podNamespace()
+}
+
+// == //
+
+// RunLeaderElection Function that campaigns for the leader Lease in the primary cluster
+// (onStarted gets a channel that is closed when the leadership is lost)
+func RunLeaderElection(stopChan chan struct{}, wg *sync.WaitGroup, onStarted func(<-chan struct{}), onStopped func()) bool {
+	namespace := leaderElectionNamespace()
+	identity := config.AgentID()
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: v1.ObjectMeta{
+			Namespace: namespace,
+			Name:      config.GlobalConfig.LeaderElectionID,
+		},
+		Client: K8sH.clientSet.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   time.Duration(config.GlobalConfig.LeaseDuration) * time.Second,
+		RenewDeadline:   time.Duration(config.GlobalConfig.RenewDeadline) * time.Second,
+		RetryPeriod:     time.Duration(config.GlobalConfig.RetryPeriod) * time.Second,
+		ReleaseOnCancel: true,
+		Name:            config.GlobalConfig.LeaderElectionID,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Printf("[LeaderElection] %s became the leader", identity)
+				onStarted(ctx.Done())
+			},
+			OnStoppedLeading: func() {
+				log.Printf("[LeaderElection] %s is no longer the leader", identity)
+				onStopped()
+			},
+			OnNewLeader: func(leader string) {
+				if leader != identity {
+					log.Printf("[LeaderElection] %s is the leader", leader)
+				}
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("[LeaderElection] Failed to initialize leader election: %v", err)
+		return false
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		// A replica that lost the leadership campaigns again until Agent stops
+		for ctx.Err() == nil {
+			elector.Run(ctx)
+		}
+	}()
+
+	go func() {
+		<-stopChan
+		cancel()
+	}()
+
+	log.Printf("[LeaderElection] Campaigning for Lease %s/%s as %s", namespace, config.GlobalConfig.LeaderElectionID, identity)
+
+	return true
+}
+
+// == //
+
+// AgentDeploymentRemoved Function that checks if the Deployment running Agent is deleted or scaled to zero
+// (a replica stopped by a restart, a rollout or a leader handover finds its Deployment still wanting replicas)
+func AgentDeploymentRemoved() bool {
+	podName := os.Getenv("POD_NAME")
+	if podName == "" || K8sH.clientSet == nil {
+		return false
+	}
+
+	namespace := podNamespace()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pod, err := K8sH.clientSet.CoreV1().Pods(namespace).Get(ctx, podName, v1.GetOptions{})
+	if err != nil {
+		log.Printf("[LeaderElection] Failed to get Pod %s/%s: %v", namespace, podName, err)
+		return false
+	}
+
+	// A ReplicaSet is named after its Deployment and the hash of the pod template
+	owner := v1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "ReplicaSet" {
+		return false
+	}
+	deployName := strings.TrimSuffix(owner.Name, "-"+pod.Labels["pod-template-hash"])
+
+	deploy, err := K8sH.clientSet.AppsV1().Deployments(namespace).Get(ctx, deployName, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return true
+	} else if err != nil {
+		log.Printf("[LeaderElection] Failed to get Deployment %s/%s: %v", namespace, deployName, err)
+		return false
+	}
+
+	return deploy.DeletionTimestamp != nil || (deploy.Spec.Replicas != nil && *deploy.Spec.Replicas == 0)
+}
+
+// == //
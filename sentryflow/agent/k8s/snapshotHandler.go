@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"Agent/config"
+	"Agent/types"
+	"Agent/uploader"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// == //
+
+// snapshotTargets maps informer stores to their cluster event resource types
+var snapshotTargets = map[string]string{
+	"pods":         "Pod",
+	"services":     "Service",
+	"deployments":  "Deploy",
+	"statefulsets": "StatefulSet",
+	"daemonsets":   "DaemonSet",
+	"jobs":         "Job",
+	"cronjobs":     "CronJob",
+	"nodes":        "Node",
+	"namespaces":   "Namespace",
+}
+
+// == //
+
+// RunClusterSnapshots Function that uploads the full cluster snapshot once informers are synced and periodically after
+func RunClusterSnapshots(stopChan <-chan struct{}, wg *sync.WaitGroup) {
+	for _, k8s := range K8sHandlers {
+		wg.Add(1)
+		go k8s.runClusterSnapshots(stopChan, wg)
+	}
+
+	log.Print("[ClusterSnapshot] Started cluster snapshot routine")
+}
+
+// runClusterSnapshots Function that uploads the snapshots of a cluster
+func (k8s *KubernetesHandler) runClusterSnapshots(stopChan <-chan struct{}, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	synced := make([]cache.InformerSynced, 0, len(k8s.informers))
+	for _, informer := range k8s.informers {
+		synced = append(synced, informer.HasSynced)
+	}
+
+	// Wait until every informer has delivered its initial list
+	if !cache.WaitForCacheSync(stopChan, synced...) {
+		log.Printf("[ClusterSnapshot] Stopped before informers of cluster %s were synced", k8s.clusterName)
+		return
+	}
+
+	// The snapshot is built when its turn to be uploaded comes, so that it is no older than the events before it
+	go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Snapshot", "SYNC", k8s.buildClusterSnapshot)
+	log.Printf("[ClusterSnapshot] Informers synced, uploading the initial snapshot of cluster %s", k8s.clusterName)
+
+	if config.GlobalConfig.SnapshotPeriod <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Duration(config.GlobalConfig.SnapshotPeriod) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			go uploader.UplH.UploadClusterEvent(k8s.clusterName, "Snapshot", "SYNC", k8s.buildClusterSnapshot)
+			log.Printf("[ClusterSnapshot] Uploading a periodic snapshot of cluster %s", k8s.clusterName)
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// buildClusterSnapshot Function that collects every cached object of the cluster
+func (k8s *KubernetesHandler) buildClusterSnapshot() *types.ClusterSnapshot {
+	snapshot := &types.ClusterSnapshot{
+		Objects: make(map[string][]interface{}),
+		BuiltAt: time.Now(),
+	}
+
+	for target, resourceType := range snapshotTargets {
+		store, ok := k8s.stores[target]
+		if !ok {
+			continue
+		}
+
+		for _, obj := range store.List() {
+			// Pods are only reported once they have an IP (same as the pod informer)
+			if pod, ok := obj.(*corev1.Pod); ok && pod.Status.PodIP == "" {
+				continue
+			}
+			snapshot.Objects[resourceType] = append(snapshot.Objects[resourceType], obj)
+		}
+	}
+
+	k8s.ingressLock.RLock()
+	for _, ing := range k8s.ingressMap {
+		snapshot.Objects["Ingress"] = append(snapshot.Objects["Ingress"], ing)
+	}
+	k8s.ingressLock.RUnlock()
+
+	k8s.endpointsLock.Lock()
+	for _, se := range k8s.serviceEndpointsMap {
+		snapshot.Objects["ServiceEndpoints"] = append(snapshot.Objects["ServiceEndpoints"], se)
+	}
+	k8s.endpointsLock.Unlock()
+
+	return snapshot
+}
+
+// == //
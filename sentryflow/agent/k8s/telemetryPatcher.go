@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"Agent/config"
+	"Agent/types"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// == //
+
+// telemetryGVR for Istio Telemetry resources
+var telemetryGVR = schema.GroupVersionResource{Group: "telemetry.istio.io", Version: "v1", Resource: "telemetries"}
+
+// Telemetry resources owned by Agent
+const (
+	telemetryName       = "sentryflow-agent"
+	labelManagedBy      = "app.kubernetes.io/managed-by"
+	labelManagedByAgent = "sentryflow-agent"
+)
+
+// telemetryNamespaces Function that returns where Telemetry resources are created
+func telemetryNamespaces() []string {
+	namespaces := []string{}
+	for _, ns := range strings.Split(config.GlobalConfig.TelemetryNamespaces, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+
+	// A Telemetry resource in the Istio root namespace applies mesh-wide
+	if len(namespaces) == 0 {
+		namespaces = append(namespaces, config.GlobalConfig.IstioNamespace)
+	}
+
+	return namespaces
+}
+
+// newTelemetry Function that builds the Telemetry resource enabling Agent as access log provider
+func newTelemetry(namespace string) *unstructured.Unstructured {
+	labels := map[string]interface{}{
+		labelManagedBy: labelManagedByAgent,
+	}
+
+	// Only the control plane of the configured revision picks up the resource
+	if rev := config.GlobalConfig.IstioRevision; rev != "" && rev != "default" {
+		labels[types.LabelIstioRevision] = rev
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": telemetryGVR.GroupVersion().String(),
+			"kind":       "Telemetry",
+			"metadata": map[string]interface{}{
+				"name":      telemetryName,
+				"namespace": namespace,
+				"labels":    labels,
+			},
+			"spec": map[string]interface{}{
+				"accessLogging": []interface{}{
+					map[string]interface{}{
+						"providers": []interface{}{
+							map[string]interface{}{"name": agentProviderName},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// == //
+
+// PatchIstioTelemetry Function that creates or updates the Telemetry resources owned by Agent
+func PatchIstioTelemetry() bool {
+	log.Print("[PatchIstioTelemetry] Patching Istio Telemetry resources")
+
+	if !K8sH.isResourceServed(telemetryGVR) {
+		log.Printf("[PatchIstioTelemetry] %s is not served by the cluster", telemetryGVR.String())
+		return false
+	}
+
+	for _, namespace := range telemetryNamespaces() {
+		if err := K8sH.applyTelemetry(namespace); err != nil {
+			log.Printf("[PatchIstioTelemetry] Failed to apply Telemetry %s/%s: %v", namespace, telemetryName, err)
+			return false
+		}
+		log.Printf("[PatchIstioTelemetry] Applied Telemetry %s/%s", namespace, telemetryName)
+	}
+
+	log.Print("[PatchIstioTelemetry] Successfully patched Istio Telemetry resources")
+
+	return true
+}
+
+// applyTelemetry Function
+func (k8s *KubernetesHandler) applyTelemetry(namespace string) error {
+	client := k8s.dynamicClient.Resource(telemetryGVR).Namespace(namespace)
+	desired := newTelemetry(namespace)
+
+	current, err := client.Get(context.Background(), telemetryName, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = client.Create(context.Background(), desired, v1.CreateOptions{FieldManager: "patcher"})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	// Never take over a Telemetry resource that someone else created
+	if current.GetLabels()[labelManagedBy] != labelManagedByAgent {
+		return fmt.Errorf("%s/%s exists and is not managed by Agent", namespace, telemetryName)
+	}
+
+	desired.SetResourceVersion(current.GetResourceVersion())
+	_, err = client.Update(context.Background(), desired, v1.UpdateOptions{FieldManager: "patcher"})
+	return err
+}
+
+// UnpatchIstioTelemetry Function that deletes every Telemetry resource owned by Agent
+func UnpatchIstioTelemetry() bool {
+	log.Print("[PatchIstioTelemetry] Unpatching Istio Telemetry resources")
+
+	if K8sH.dynamicClient == nil {
+		return false
+	}
+
+	selector := fmt.Sprintf("%s=%s", labelManagedBy, labelManagedByAgent)
+	telemetries, err := K8sH.dynamicClient.Resource(telemetryGVR).List(context.Background(), v1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		log.Printf("[PatchIstioTelemetry] Failed to get Telemetry resources: %v", err)
+		return false
+	}
+
+	for _, telemetry := range telemetries.Items {
+		err := K8sH.dynamicClient.Resource(telemetryGVR).Namespace(telemetry.GetNamespace()).Delete(context.Background(), telemetry.GetName(), v1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			log.Printf("[PatchIstioTelemetry] Failed to delete Telemetry %s/%s: %v", telemetry.GetNamespace(), telemetry.GetName(), err)
+			return false
+		}
+		log.Printf("[PatchIstioTelemetry] Deleted Telemetry %s/%s", telemetry.GetNamespace(), telemetry.GetName())
+	}
+
+	log.Print("[PatchIstioTelemetry] Successfully unpatched Istio Telemetry resources")
+
+	return true
+}
+
+// == //
@@ -21,8 +21,10 @@ func init() {
 type LogHandler struct {
 	stopChan chan struct{}
 
-	apiLogChan  chan interface{}
-	metricsChan chan interface{}
+	apiLogChan   chan interface{}
+	metricsChan  chan interface{}
+	connLogChan  chan interface{}
+	auditLogChan chan interface{}
 }
 
 // NewLogHandler Structure
@@ -30,8 +32,10 @@ func NewLogHandler() *LogHandler {
 	lh := &LogHandler{
 		stopChan: make(chan struct{}),
 
-		apiLogChan:  make(chan interface{}),
-		metricsChan: make(chan interface{}),
+		apiLogChan:   make(chan interface{}),
+		metricsChan:  make(chan interface{}),
+		connLogChan:  make(chan interface{}),
+		auditLogChan: make(chan interface{}),
 	}
 
 	return lh
@@ -47,6 +51,12 @@ func StartLogProcessor(wg *sync.WaitGroup) bool {
 	// handle Envoy metrics
 	go processEnvoyMetrics(wg)
 
+	// handle connection logs (ambient mesh)
+	go processConnectionLogs(wg)
+
+	// handle audit logs
+	go processAuditLogs(wg)
+
 	log.Print("[LogProcessor] Started Log Processors")
 
 	return true
@@ -60,6 +70,12 @@ func StopLogProcessor() bool {
 	// One for processMetrics
 	LogH.stopChan <- struct{}{}
 
+	// One for processConnectionLogs
+	LogH.stopChan <- struct{}{}
+
+	// One for processAuditLogs
+	LogH.stopChan <- struct{}{}
+
 	log.Print("[LogProcessor] Stopped Log Processors")
 
 	return true
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package processor
+
+import (
+	"log"
+	"sync"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"Agent/uploader"
+)
+
+// InsertAuditLog Function
+func InsertAuditLog(data interface{}) {
+	LogH.auditLogChan <- data
+}
+
+// processAuditLogs Function
+func processAuditLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+
+	for {
+		select {
+		case logType, ok := <-LogH.auditLogChan:
+			if !ok {
+				log.Print("[LogProcessor] Failed to process an audit log")
+			}
+
+			go uploader.UploadAuditLog(logType.(*protobuf.AuditLog))
+
+		case <-LogH.stopChan:
+			wg.Done()
+			return
+		}
+	}
+}
+
+// == //
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package processor
+
+import (
+	"log"
+	"sync"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"Agent/uploader"
+)
+
+// InsertConnectionLog Function
+func InsertConnectionLog(data interface{}) {
+	LogH.connLogChan <- data
+}
+
+// processConnectionLogs Function
+func processConnectionLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+
+	for {
+		select {
+		case logType, ok := <-LogH.connLogChan:
+			if !ok {
+				log.Print("[LogProcessor] Failed to process a connection log")
+			}
+
+			go uploader.UploadConnectionLog(logType.(*protobuf.ConnectionLog))
+
+		case <-LogH.stopChan:
+			wg.Done()
+			return
+		}
+	}
+}
+
+// == //
@@ -2,6 +2,8 @@
 
 package types
 
+import "time"
+
 // == //
 
 // K8sResourceTypes
@@ -9,12 +11,52 @@ const (
 	K8sResourceTypeUnknown = 0
 	K8sResourceTypePod     = 1
 	K8sResourceTypeService = 2
+	K8sResourceTypeIngress = 3
+)
+
+// Node topology labels
+const (
+	LabelTopologyZone       = "topology.kubernetes.io/zone"
+	LabelTopologyRegion     = "topology.kubernetes.io/region"
+	LabelInstanceType       = "node.kubernetes.io/instance-type"
+	LabelBetaTopologyZone   = "failure-domain.beta.kubernetes.io/zone"
+	LabelBetaTopologyRegion = "failure-domain.beta.kubernetes.io/region"
+	LabelBetaInstanceType   = "beta.kubernetes.io/instance-type"
+)
+
+// Namespace mesh labels
+const (
+	LabelIstioInjection     = "istio-injection"
+	LabelIstioRevision      = "istio.io/rev"
+	LabelIstioDataplaneMode = "istio.io/dataplane-mode"
+)
+
+// Ambient mesh proxy labels
+const (
+	LabelApp              = "app"
+	LabelGatewayClassName = "gateway.networking.k8s.io/gateway-class-name"
+	LabelIstioWaypointFor = "istio.io/waypoint-for"
+	ZtunnelAppName        = "ztunnel"
+	WaypointGatewayClass  = "istio-waypoint"
+)
+
+// Annotations managed by Agent
+const (
+	AnnotationOriginalLabels = "sentryflow.io/original-labels"     // labels as they were before patching
+	AnnotationRestartedAt    = "kubectl.kubernetes.io/restartedAt" // same as 'kubectl rollout restart'
 )
 
 type ClusterEvent struct {
-	ResourceType string      // "Pod" / "Service" / "Deploy"
+	Cluster      string      // Name of the cluster that the object belongs to
+	ResourceType string      // "Pod" / "Service" / "Deploy" / "StatefulSet" / "DaemonSet" / "Job" / "CronJob" / "Node" / "Namespace" / "Ingress" / "ServiceEndpoints"
 	Action       string      // "ADD", "UPDATE", "DELETE"
-	Object       interface{} // *corev1.Pod, *corev1.Service, *appsv1.Deployment
+	Object       interface{} // *corev1.Pod, *corev1.Service, *appsv1.Deployment, *appsv1.StatefulSet, ... (func() *ClusterSnapshot for "Snapshot")
+}
+
+// ClusterSnapshot Structure that holds every cached object, keyed by ClusterEvent.ResourceType
+type ClusterSnapshot struct {
+	Objects map[string][]interface{}
+	BuiltAt time.Time // when the objects were read from the informer caches
 }
 
 // K8sResource Structure
@@ -25,6 +67,97 @@ type K8sResource struct {
 	Name       string
 	Labels     map[string]string
 	Containers []string
+	Zone       string
+}
+
+// Ingress Structure that describes a north-south entry point
+// (Ingress, Gateway API Gateway/HTTPRoute, Istio Gateway/VirtualService)
+type Ingress struct {
+	Namespace         string
+	Name              string
+	Kind              string
+	ClassName         string
+	Hosts             []string
+	Addresses         []string
+	Gateways          []string
+	Rules             []IngressRule
+	Labels            map[string]string
+	CreationTimestamp string
+}
+
+// IngressRule Structure
+type IngressRule struct {
+	Host             string
+	Path             string
+	BackendNamespace string
+	BackendService   string
+	BackendPort      int32
+}
+
+// ServiceEndpoints Structure that lists the pods backing a service
+type ServiceEndpoints struct {
+	Namespace     string
+	Name          string
+	Endpoints     []EndpointRef
+	ReadyCount    int32
+	NotReadyCount int32
+}
+
+// EndpointRef Structure
+type EndpointRef struct {
+	IP           string
+	PodNamespace string
+	PodName      string
+	NodeName     string
+	Zone         string
+	Ready        bool
+}
+
+// AuditEventList Structure (audit.k8s.io/v1 EventList, only the fields that Agent reads)
+type AuditEventList struct {
+	Kind       string       `json:"kind"`
+	APIVersion string       `json:"apiVersion"`
+	Items      []AuditEvent `json:"items"`
+}
+
+// AuditEvent Structure
+type AuditEvent struct {
+	AuditID          string               `json:"auditID"`
+	Stage            string               `json:"stage"`
+	RequestURI       string               `json:"requestURI"`
+	Verb             string               `json:"verb"`
+	User             AuditUser            `json:"user"`
+	ImpersonatedUser *AuditUser           `json:"impersonatedUser,omitempty"`
+	SourceIPs        []string             `json:"sourceIPs,omitempty"`
+	UserAgent        string               `json:"userAgent,omitempty"`
+	ObjectRef        *AuditObjectRef      `json:"objectRef,omitempty"`
+	ResponseStatus   *AuditResponseStatus `json:"responseStatus,omitempty"`
+	StageTimestamp   time.Time            `json:"stageTimestamp"`
+}
+
+// AuditUser Structure
+type AuditUser struct {
+	Username string              `json:"username"`
+	UID      string              `json:"uid,omitempty"`
+	Groups   []string            `json:"groups,omitempty"`
+	Extra    map[string][]string `json:"extra,omitempty"`
+}
+
+// AuditObjectRef Structure
+type AuditObjectRef struct {
+	Resource    string `json:"resource,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name,omitempty"`
+	APIGroup    string `json:"apiGroup,omitempty"`
+	APIVersion  string `json:"apiVersion,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+}
+
+// AuditResponseStatus Structure
+type AuditResponseStatus struct {
+	Code    int32  `json:"code,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
 }
 
 // K8sResourceTypeToString Function
@@ -34,10 +167,52 @@ func K8sResourceTypeToString(resourceType uint8) string {
 		return "Pod"
 	case K8sResourceTypeService:
 		return "Service"
+	case K8sResourceTypeIngress:
+		return "Ingress"
 	case K8sResourceTypeUnknown:
 		return "Unknown"
 	}
 	return "Unknown"
 }
 
+// LookupLabel Function that returns the first non-empty value among the given label keys
+func LookupLabel(labels map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if value, ok := labels[key]; ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// IsNamespaceMeshed Function that checks if workloads in a namespace join the Istio mesh
+func IsNamespaceMeshed(labels map[string]string) bool {
+	switch labels[LabelIstioInjection] {
+	case "enabled":
+		return true
+	case "disabled":
+		return false
+	}
+
+	if labels[LabelIstioRevision] != "" {
+		return true
+	}
+
+	return labels[LabelIstioDataplaneMode] == "ambient"
+}
+
+// IsAmbientProxy Function that checks if a pod is a ztunnel or a waypoint proxy of the ambient mesh
+func IsAmbientProxy(labels map[string]string) bool {
+	if labels[LabelApp] == ZtunnelAppName {
+		return true
+	}
+
+	if labels[LabelGatewayClassName] == WaypointGatewayClass {
+		return true
+	}
+
+	_, ok := labels[LabelIstioWaypointFor]
+	return ok
+}
+
 // == //
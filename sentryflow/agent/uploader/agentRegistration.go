@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package uploader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"Agent/config"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// agentIDMetadataKey is the gRPC metadata key that identifies Agent on every call to Operator
+const agentIDMetadataKey = "sentryflow-agent-id"
+
+// agentIdentity Structure that presents the identity of Agent on every call to Operator
+type agentIdentity struct {
+	agentID string
+}
+
+// GetRequestMetadata Function
+func (a *agentIdentity) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{agentIDMetadataKey: a.agentID}, nil
+}
+
+// RequireTransportSecurity Function
+func (a *agentIdentity) RequireTransportSecurity() bool {
+	return false
+}
+
+// == //
+
+// enabledCollectors Function that returns the names of the collectors that Agent runs
+func enabledCollectors() []string {
+	collectors := []string{"otel", "envoy-access-logs", "envoy-metrics"}
+
+	if config.GlobalConfig.AmbientMode {
+		collectors = append(collectors, "ztunnel")
+	}
+	if config.GlobalConfig.HubbleEnabled {
+		collectors = append(collectors, "hubble")
+	}
+	if config.GlobalConfig.AuditWebhook {
+		collectors = append(collectors, "audit-webhook")
+	}
+	if config.GlobalConfig.ClusterNotices {
+		collectors = append(collectors, "cluster-notices")
+	}
+
+	return collectors
+}
+
+// configHash Function that returns a short hash of the configuration of Agent
+// (agents of the same fleet running with different settings have different hashes)
+func configHash() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%+v", config.GlobalConfig)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// agentIPAddress Function that returns the IP address of Agent
+func agentIPAddress() string {
+	if ip := os.Getenv("POD_IP"); ip != "" {
+		return ip
+	}
+
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return ""
+	}
+
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && !ipNet.IP.IsLoopback() && ipNet.IP.To4() != nil {
+			return ipNet.IP.String()
+		}
+	}
+	return ""
+}
+
+// SetWatchedClusters Function that gives the clusters Agent watches (the first one is the primary),
+// after which Agent registers with Operator
+func SetWatchedClusters(clusters []string) {
+	UplH.watchedClusters <- clusters
+}
+
+// generateAgentInfo Function
+func generateAgentInfo(clusters []string) *protobuf.AgentInfo {
+	hostname, _ := os.Hostname()
+
+	cluster := config.GlobalConfig.ClusterName
+	if len(clusters) > 0 {
+		cluster = clusters[0]
+	}
+
+	return &protobuf.AgentInfo{
+		AgentID:    config.AgentID(),
+		Cluster:    cluster,
+		Clusters:   clusters,
+		Version:    config.Version,
+		Collectors: enabledCollectors(),
+		ConfigHash: configHash(),
+
+		HostName:  hostname,
+		IPAddress: agentIPAddress(),
+	}
+}
+
+// == //
+
+// runHeartbeats Function that registers Agent with Operator and keeps sending heartbeats
+func (upl *UplHandler) runHeartbeats(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	period := time.Duration(config.GlobalConfig.HeartbeatPeriod) * time.Second
+	if period <= 0 {
+		period = 15 * time.Second
+	}
+
+	// The clusters are known once the kubeconfig is loaded
+	var clusters []string
+	select {
+	case clusters = <-upl.watchedClusters:
+	case <-upl.stopChan:
+		return
+	}
+
+	info := generateAgentInfo(clusters)
+	registered := upl.registerAgent(info)
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !registered {
+				registered = upl.registerAgent(info)
+				continue
+			}
+
+			known, err := upl.sendHeartbeat(info)
+			if err != nil {
+				log.Printf("[Uploader] Failed to send a heartbeat: %v", err)
+				continue
+			}
+
+			// Operator forgets agents when it restarts, so register again
+			if !known {
+				registered = upl.registerAgent(info)
+			}
+
+		case <-upl.stopChan:
+			return
+		}
+	}
+}
+
+// registerAgent Function
+func (upl *UplHandler) registerAgent(info *protobuf.AgentInfo) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := upl.grpcClient.RegisterAgent(ctx, info); err != nil {
+		log.Printf("[Uploader] Failed to register Agent %s with Operator: %v", info.AgentID, err)
+		return false
+	}
+
+	log.Printf("[Uploader] Registered Agent %s (clusters=%v, version=%s, config=%s)", info.AgentID, info.Clusters, info.Version, info.ConfigHash)
+	return true
+}
+
+// sendHeartbeat Function that returns false if Operator does not know Agent
+func (upl *UplHandler) sendHeartbeat(info *protobuf.AgentInfo) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := upl.grpcClient.Heartbeat(ctx, &protobuf.AgentHeartbeat{
+		AgentID:    info.AgentID,
+		Cluster:    info.Cluster,
+		TimeStamp:  strconv.FormatInt(time.Now().Unix(), 10),
+		ConfigHash: info.ConfigHash,
+		Leader:     upl.clusterEventsEnabled.Load(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Msg == 0, nil
+}
+
+// == //
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package uploader
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"Agent/config"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// == //
+
+// tokenCredentials Structure that presents a bearer token on every call to Operator
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+// GetRequestMetadata Function
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity Function
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return t.requireTLS
+}
+
+// operatorDialOptions Function that returns the TLS and token options for connecting to Operator
+func operatorDialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{}
+
+	useTLS := config.GlobalConfig.OperatorTLSCA != ""
+
+	if useTLS {
+		caPEM, err := os.ReadFile(config.GlobalConfig.OperatorTLSCA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the Operator CA: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no certificate found in the Operator CA")
+		}
+
+		tlsConfig := &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+
+		if config.GlobalConfig.OperatorTLSCert != "" {
+			cert, err := tls.LoadX509KeyPair(config.GlobalConfig.OperatorTLSCert, config.GlobalConfig.OperatorTLSKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load the client key pair: %w", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	if config.GlobalConfig.OperatorTokenFile != "" {
+		data, err := os.ReadFile(config.GlobalConfig.OperatorTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the Operator token: %w", err)
+		}
+
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{
+			token:      strings.TrimSpace(string(data)),
+			requireTLS: useTLS,
+		}))
+	}
+
+	// Operator attributes every call to the Agent that made it
+	opts = append(opts, grpc.WithPerRPCCredentials(&agentIdentity{agentID: config.AgentID()}))
+
+	return opts, nil
+}
+
+// == //
@@ -0,0 +1,61 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// UploadAuditLog Function
+func UploadAuditLog(auditLog *protobuf.AuditLog) {
+	UplH.uploaderAuditLogs <- auditLog
+}
+
+// uploadAuditLogs Function
+func (upl *UplHandler) uploadAuditLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+
+	for {
+		select {
+		case auditLog, ok := <-upl.uploaderAuditLogs:
+			if !ok {
+				log.Printf("[Uploader] Failed to fetch audit logs from audit logs channel")
+				wg.Done()
+				return
+			}
+
+			if err := upl.sendAuditLogs(auditLog); err != nil {
+				log.Printf("[Uploader] Failed to upload audit logs: %v", err)
+			}
+
+		case <-upl.stopChan:
+			wg.Done()
+			return
+		}
+	}
+}
+
+// sendAuditLogs Function
+func (upl *UplHandler) sendAuditLogs(auditLog *protobuf.AuditLog) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := upl.grpcClient.GiveAuditLog(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open GiveAuditLog stream: %w", err)
+	}
+
+	if err := stream.Send(auditLog); err != nil {
+		return fmt.Errorf("failed to send AuditLog: %w", err)
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("failed to close GiveAuditLog stream: %w", err)
+	}
+	log.Printf("[Uploader] Audit Log sent, response: %v", resp)
+	return nil
+}
@@ -6,17 +6,24 @@ import (
 	"sync"
 	"time"
 
-	"Agent/config"
 	"Agent/types"
 
 	"github.com/Jitria/SentryFlow/protobuf"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
 // UploadClusterEvent Function
-func (upl *UplHandler) UploadClusterEvent(resourceType, action string, obj interface{}) {
+func (upl *UplHandler) UploadClusterEvent(cluster, resourceType, action string, obj interface{}) {
+	if !upl.clusterEventsEnabled.Load() {
+		return
+	}
+
 	event := &types.ClusterEvent{
+		Cluster:      cluster,
 		ResourceType: resourceType,
 		Action:       action,
 		Object:       obj,
@@ -47,11 +54,29 @@ func (upl *UplHandler) uploadClusterEvent(wg *sync.WaitGroup) {
 func (upl *UplHandler) handleClusterEvent(evt *types.ClusterEvent) {
 	switch evt.ResourceType {
 	case "Pod":
-		upl.handlePodEvent(evt.Action, evt.Object)
+		upl.handlePodEvent(evt.Cluster, evt.Action, evt.Object)
 	case "Service":
-		upl.handleServiceEvent(evt.Action, evt.Object)
+		upl.handleServiceEvent(evt.Cluster, evt.Action, evt.Object)
 	case "Deploy":
-		upl.handleDeployEvent(evt.Action, evt.Object)
+		upl.handleDeployEvent(evt.Cluster, evt.Action, evt.Object)
+	case "StatefulSet":
+		upl.handleStatefulSetEvent(evt.Cluster, evt.Action, evt.Object)
+	case "DaemonSet":
+		upl.handleDaemonSetEvent(evt.Cluster, evt.Action, evt.Object)
+	case "Job":
+		upl.handleJobEvent(evt.Cluster, evt.Action, evt.Object)
+	case "CronJob":
+		upl.handleCronJobEvent(evt.Cluster, evt.Action, evt.Object)
+	case "Node":
+		upl.handleNodeEvent(evt.Cluster, evt.Action, evt.Object)
+	case "Namespace":
+		upl.handleNamespaceEvent(evt.Cluster, evt.Action, evt.Object)
+	case "Ingress":
+		upl.handleIngressEvent(evt.Cluster, evt.Action, evt.Object)
+	case "ServiceEndpoints":
+		upl.handleServiceEndpointsEvent(evt.Cluster, evt.Action, evt.Object)
+	case "Snapshot":
+		upl.handleClusterSnapshot(evt.Cluster, evt.Object)
 	default:
 		log.Printf("[Uploader] Unknown resource type: %s", evt.ResourceType)
 	}
@@ -60,7 +85,7 @@ func (upl *UplHandler) handleClusterEvent(evt *types.ClusterEvent) {
 // == //
 
 // handlePodEvent Function
-func (upl *UplHandler) handlePodEvent(action string, obj interface{}) {
+func (upl *UplHandler) handlePodEvent(cluster, action string, obj interface{}) {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
 		log.Printf("[Uploader] handlePodEvent: Not a *corev1.Pod object")
@@ -70,7 +95,7 @@ func (upl *UplHandler) handlePodEvent(action string, obj interface{}) {
 		return
 	}
 
-	podProto := convertPodToProto(pod)
+	podProto := convertPodToProto(cluster, pod)
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -117,7 +142,7 @@ func (upl *UplHandler) handlePodEvent(action string, obj interface{}) {
 }
 
 // handleServiceEvent Function
-func (upl *UplHandler) handleServiceEvent(action string, obj interface{}) {
+func (upl *UplHandler) handleServiceEvent(cluster, action string, obj interface{}) {
 	svc, ok := obj.(*corev1.Service)
 	if !ok {
 		log.Printf("[Uploader] handleServiceEvent: Not a *corev1.Service object")
@@ -127,7 +152,7 @@ func (upl *UplHandler) handleServiceEvent(action string, obj interface{}) {
 		return
 	}
 
-	svcProto := convertServiceToProto(svc)
+	svcProto := convertServiceToProto(cluster, svc)
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -174,7 +199,7 @@ func (upl *UplHandler) handleServiceEvent(action string, obj interface{}) {
 }
 
 // handleDeployEvent Function
-func (upl *UplHandler) handleDeployEvent(action string, obj interface{}) {
+func (upl *UplHandler) handleDeployEvent(cluster, action string, obj interface{}) {
 	dep, ok := obj.(*appsv1.Deployment)
 	if !ok {
 		log.Printf("[Uploader] handleDeployEvent: Not a *appsv1.Deployment object")
@@ -184,7 +209,7 @@ func (upl *UplHandler) handleDeployEvent(action string, obj interface{}) {
 		return
 	}
 
-	depProto := convertDeploymentToProto(dep)
+	depProto := convertDeploymentToProto(cluster, dep)
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -230,12 +255,529 @@ func (upl *UplHandler) handleDeployEvent(action string, obj interface{}) {
 	}
 }
 
+// handleStatefulSetEvent Function
+func (upl *UplHandler) handleStatefulSetEvent(cluster, action string, obj interface{}) {
+	sts, ok := obj.(*appsv1.StatefulSet)
+	if !ok {
+		log.Printf("[Uploader] handleStatefulSetEvent: Not a *appsv1.StatefulSet object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	stsProto := convertStatefulSetToProto(cluster, sts)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch action {
+	case "ADD":
+		resp, err := upl.grpcClient.AddStatefulSetEvent(ctx, stsProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to AddStatefulSetEvent for StatefulSet %s/%s: %v",
+				sts.Namespace, sts.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleStatefulSetEvent: ADD StatefulSet %s/%s => Operator resp=%v",
+			sts.Namespace, sts.Name, resp)
+
+	case "UPDATE":
+		resp, err := upl.grpcClient.UpdateStatefulSetEvent(ctx, stsProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to UpdateStatefulSetEvent for StatefulSet %s/%s: %v",
+				sts.Namespace, sts.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleStatefulSetEvent: UPDATE StatefulSet %s/%s => Operator resp=%v",
+			sts.Namespace, sts.Name, resp)
+
+	case "DELETE":
+		delStatefulSetProto := &protobuf.StatefulSet{
+			Cluster:   stsProto.Cluster,
+			Namespace: sts.Namespace,
+			Name:      sts.Name,
+		}
+		resp, err := upl.grpcClient.DeleteStatefulSetEvent(ctx, delStatefulSetProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to DeleteStatefulSetEvent for StatefulSet %s/%s: %v",
+				sts.Namespace, sts.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleStatefulSetEvent: DELETE StatefulSet %s/%s => Operator resp=%v",
+			sts.Namespace, sts.Name, resp)
+
+	default:
+		log.Printf("[Uploader] handleStatefulSetEvent: Unrecognized action=%s for StatefulSet %s/%s",
+			action, sts.Namespace, sts.Name)
+	}
+}
+
+// handleDaemonSetEvent Function
+func (upl *UplHandler) handleDaemonSetEvent(cluster, action string, obj interface{}) {
+	ds, ok := obj.(*appsv1.DaemonSet)
+	if !ok {
+		log.Printf("[Uploader] handleDaemonSetEvent: Not a *appsv1.DaemonSet object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	dsProto := convertDaemonSetToProto(cluster, ds)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch action {
+	case "ADD":
+		resp, err := upl.grpcClient.AddDaemonSetEvent(ctx, dsProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to AddDaemonSetEvent for DaemonSet %s/%s: %v",
+				ds.Namespace, ds.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleDaemonSetEvent: ADD DaemonSet %s/%s => Operator resp=%v",
+			ds.Namespace, ds.Name, resp)
+
+	case "UPDATE":
+		resp, err := upl.grpcClient.UpdateDaemonSetEvent(ctx, dsProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to UpdateDaemonSetEvent for DaemonSet %s/%s: %v",
+				ds.Namespace, ds.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleDaemonSetEvent: UPDATE DaemonSet %s/%s => Operator resp=%v",
+			ds.Namespace, ds.Name, resp)
+
+	case "DELETE":
+		delDaemonSetProto := &protobuf.DaemonSet{
+			Cluster:   dsProto.Cluster,
+			Namespace: ds.Namespace,
+			Name:      ds.Name,
+		}
+		resp, err := upl.grpcClient.DeleteDaemonSetEvent(ctx, delDaemonSetProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to DeleteDaemonSetEvent for DaemonSet %s/%s: %v",
+				ds.Namespace, ds.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleDaemonSetEvent: DELETE DaemonSet %s/%s => Operator resp=%v",
+			ds.Namespace, ds.Name, resp)
+
+	default:
+		log.Printf("[Uploader] handleDaemonSetEvent: Unrecognized action=%s for DaemonSet %s/%s",
+			action, ds.Namespace, ds.Name)
+	}
+}
+
+// handleJobEvent Function
+func (upl *UplHandler) handleJobEvent(cluster, action string, obj interface{}) {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		log.Printf("[Uploader] handleJobEvent: Not a *batchv1.Job object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	jobProto := convertJobToProto(cluster, job)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch action {
+	case "ADD":
+		resp, err := upl.grpcClient.AddJobEvent(ctx, jobProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to AddJobEvent for Job %s/%s: %v",
+				job.Namespace, job.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleJobEvent: ADD Job %s/%s => Operator resp=%v",
+			job.Namespace, job.Name, resp)
+
+	case "UPDATE":
+		resp, err := upl.grpcClient.UpdateJobEvent(ctx, jobProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to UpdateJobEvent for Job %s/%s: %v",
+				job.Namespace, job.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleJobEvent: UPDATE Job %s/%s => Operator resp=%v",
+			job.Namespace, job.Name, resp)
+
+	case "DELETE":
+		delJobProto := &protobuf.Job{
+			Cluster:   jobProto.Cluster,
+			Namespace: job.Namespace,
+			Name:      job.Name,
+		}
+		resp, err := upl.grpcClient.DeleteJobEvent(ctx, delJobProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to DeleteJobEvent for Job %s/%s: %v",
+				job.Namespace, job.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleJobEvent: DELETE Job %s/%s => Operator resp=%v",
+			job.Namespace, job.Name, resp)
+
+	default:
+		log.Printf("[Uploader] handleJobEvent: Unrecognized action=%s for Job %s/%s",
+			action, job.Namespace, job.Name)
+	}
+}
+
+// handleCronJobEvent Function
+func (upl *UplHandler) handleCronJobEvent(cluster, action string, obj interface{}) {
+	cj, ok := obj.(*batchv1.CronJob)
+	if !ok {
+		log.Printf("[Uploader] handleCronJobEvent: Not a *batchv1.CronJob object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	cjProto := convertCronJobToProto(cluster, cj)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch action {
+	case "ADD":
+		resp, err := upl.grpcClient.AddCronJobEvent(ctx, cjProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to AddCronJobEvent for CronJob %s/%s: %v",
+				cj.Namespace, cj.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleCronJobEvent: ADD CronJob %s/%s => Operator resp=%v",
+			cj.Namespace, cj.Name, resp)
+
+	case "UPDATE":
+		resp, err := upl.grpcClient.UpdateCronJobEvent(ctx, cjProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to UpdateCronJobEvent for CronJob %s/%s: %v",
+				cj.Namespace, cj.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleCronJobEvent: UPDATE CronJob %s/%s => Operator resp=%v",
+			cj.Namespace, cj.Name, resp)
+
+	case "DELETE":
+		delCronJobProto := &protobuf.CronJob{
+			Cluster:   cjProto.Cluster,
+			Namespace: cj.Namespace,
+			Name:      cj.Name,
+		}
+		resp, err := upl.grpcClient.DeleteCronJobEvent(ctx, delCronJobProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to DeleteCronJobEvent for CronJob %s/%s: %v",
+				cj.Namespace, cj.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleCronJobEvent: DELETE CronJob %s/%s => Operator resp=%v",
+			cj.Namespace, cj.Name, resp)
+
+	default:
+		log.Printf("[Uploader] handleCronJobEvent: Unrecognized action=%s for CronJob %s/%s",
+			action, cj.Namespace, cj.Name)
+	}
+}
+
+// handleNodeEvent Function
+func (upl *UplHandler) handleNodeEvent(cluster, action string, obj interface{}) {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		log.Printf("[Uploader] handleNodeEvent: Not a *corev1.Node object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	nodeProto := convertNodeToProto(cluster, node)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch action {
+	case "ADD":
+		resp, err := upl.grpcClient.AddNodeEvent(ctx, nodeProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to AddNodeEvent for Node %s: %v", node.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleNodeEvent: ADD Node %s => Operator resp=%v", node.Name, resp)
+
+	case "UPDATE":
+		resp, err := upl.grpcClient.UpdateNodeEvent(ctx, nodeProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to UpdateNodeEvent for Node %s: %v", node.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleNodeEvent: UPDATE Node %s => Operator resp=%v", node.Name, resp)
+
+	case "DELETE":
+		delNodeProto := &protobuf.Node{
+			Cluster: nodeProto.Cluster,
+			Name:    node.Name,
+		}
+		resp, err := upl.grpcClient.DeleteNodeEvent(ctx, delNodeProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to DeleteNodeEvent for Node %s: %v", node.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleNodeEvent: DELETE Node %s => Operator resp=%v", node.Name, resp)
+
+	default:
+		log.Printf("[Uploader] handleNodeEvent: Unrecognized action=%s for Node %s", action, node.Name)
+	}
+}
+
+// handleNamespaceEvent Function
+func (upl *UplHandler) handleNamespaceEvent(cluster, action string, obj interface{}) {
+	ns, ok := obj.(*corev1.Namespace)
+	if !ok {
+		log.Printf("[Uploader] handleNamespaceEvent: Not a *corev1.Namespace object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	nsProto := convertNamespaceToProto(cluster, ns)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch action {
+	case "ADD":
+		resp, err := upl.grpcClient.AddNamespaceEvent(ctx, nsProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to AddNamespaceEvent for Namespace %s: %v", ns.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleNamespaceEvent: ADD Namespace %s => Operator resp=%v", ns.Name, resp)
+
+	case "UPDATE":
+		resp, err := upl.grpcClient.UpdateNamespaceEvent(ctx, nsProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to UpdateNamespaceEvent for Namespace %s: %v", ns.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleNamespaceEvent: UPDATE Namespace %s => Operator resp=%v", ns.Name, resp)
+
+	case "DELETE":
+		delNamespaceProto := &protobuf.Namespace{
+			Cluster: nsProto.Cluster,
+			Name:    ns.Name,
+		}
+		resp, err := upl.grpcClient.DeleteNamespaceEvent(ctx, delNamespaceProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to DeleteNamespaceEvent for Namespace %s: %v", ns.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleNamespaceEvent: DELETE Namespace %s => Operator resp=%v", ns.Name, resp)
+
+	default:
+		log.Printf("[Uploader] handleNamespaceEvent: Unrecognized action=%s for Namespace %s", action, ns.Name)
+	}
+}
+
+// handleIngressEvent Function
+func (upl *UplHandler) handleIngressEvent(cluster, action string, obj interface{}) {
+	ing, ok := obj.(*types.Ingress)
+	if !ok {
+		log.Printf("[Uploader] handleIngressEvent: Not a *types.Ingress object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	ingProto := convertIngressToProto(cluster, ing)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch action {
+	case "ADD":
+		resp, err := upl.grpcClient.AddIngressEvent(ctx, ingProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to AddIngressEvent for %s %s/%s: %v",
+				ing.Kind, ing.Namespace, ing.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleIngressEvent: ADD %s %s/%s => Operator resp=%v",
+			ing.Kind, ing.Namespace, ing.Name, resp)
+
+	case "UPDATE":
+		resp, err := upl.grpcClient.UpdateIngressEvent(ctx, ingProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to UpdateIngressEvent for %s %s/%s: %v",
+				ing.Kind, ing.Namespace, ing.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleIngressEvent: UPDATE %s %s/%s => Operator resp=%v",
+			ing.Kind, ing.Namespace, ing.Name, resp)
+
+	case "DELETE":
+		delIngressProto := &protobuf.Ingress{
+			Cluster:   ingProto.Cluster,
+			Namespace: ing.Namespace,
+			Name:      ing.Name,
+			Kind:      ing.Kind,
+		}
+		resp, err := upl.grpcClient.DeleteIngressEvent(ctx, delIngressProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to DeleteIngressEvent for %s %s/%s: %v",
+				ing.Kind, ing.Namespace, ing.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleIngressEvent: DELETE %s %s/%s => Operator resp=%v",
+			ing.Kind, ing.Namespace, ing.Name, resp)
+
+	default:
+		log.Printf("[Uploader] handleIngressEvent: Unrecognized action=%s for %s %s/%s",
+			action, ing.Kind, ing.Namespace, ing.Name)
+	}
+}
+
+// handleServiceEndpointsEvent Function
+func (upl *UplHandler) handleServiceEndpointsEvent(cluster, action string, obj interface{}) {
+	se, ok := obj.(*types.ServiceEndpoints)
+	if !ok {
+		log.Printf("[Uploader] handleServiceEndpointsEvent: Not a *types.ServiceEndpoints object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	seProto := convertServiceEndpointsToProto(cluster, se)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch action {
+	case "ADD":
+		resp, err := upl.grpcClient.AddServiceEndpointsEvent(ctx, seProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to AddServiceEndpointsEvent for Service %s/%s: %v",
+				se.Namespace, se.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleServiceEndpointsEvent: ADD ServiceEndpoints %s/%s => Operator resp=%v",
+			se.Namespace, se.Name, resp)
+
+	case "UPDATE":
+		resp, err := upl.grpcClient.UpdateServiceEndpointsEvent(ctx, seProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to UpdateServiceEndpointsEvent for Service %s/%s: %v",
+				se.Namespace, se.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleServiceEndpointsEvent: UPDATE ServiceEndpoints %s/%s => Operator resp=%v",
+			se.Namespace, se.Name, resp)
+
+	case "DELETE":
+		delServiceEndpointsProto := &protobuf.ServiceEndpoints{
+			Cluster:   seProto.Cluster,
+			Namespace: se.Namespace,
+			Name:      se.Name,
+		}
+		resp, err := upl.grpcClient.DeleteServiceEndpointsEvent(ctx, delServiceEndpointsProto)
+		if err != nil {
+			log.Printf("[Uploader] Failed to DeleteServiceEndpointsEvent for Service %s/%s: %v",
+				se.Namespace, se.Name, err)
+			return
+		}
+		log.Printf("[Uploader] handleServiceEndpointsEvent: DELETE ServiceEndpoints %s/%s => Operator resp=%v",
+			se.Namespace, se.Name, resp)
+
+	default:
+		log.Printf("[Uploader] handleServiceEndpointsEvent: Unrecognized action=%s for Service %s/%s",
+			action, se.Namespace, se.Name)
+	}
+}
+
+// handleClusterSnapshot Function that builds a snapshot and uploads it
+// (cluster events are uploaded in order, so the snapshot holds everything the events before it changed,
+// and Operator only lets events that arrive after it take precedence)
+func (upl *UplHandler) handleClusterSnapshot(cluster string, obj interface{}) {
+	buildSnapshot, ok := obj.(func() *types.ClusterSnapshot)
+	if !ok {
+		log.Printf("[Uploader] handleClusterSnapshot: Not a func() *types.ClusterSnapshot object")
+		return
+	}
+	if upl.grpcClient == nil {
+		return
+	}
+
+	snapProto := convertClusterSnapshotToProto(cluster, buildSnapshot())
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	stream, err := upl.grpcClient.SyncClusterSnapshot(ctx)
+	if err != nil {
+		log.Printf("[Uploader] Failed to open SyncClusterSnapshot stream for cluster %s: %v", snapProto.Cluster, err)
+		return
+	}
+
+	chunks := splitClusterSnapshot(snapProto)
+	for _, chunk := range chunks {
+		if err := stream.Send(chunk); err != nil {
+			log.Printf("[Uploader] Failed to SyncClusterSnapshot for cluster %s: %v", snapProto.Cluster, err)
+			return
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Printf("[Uploader] Failed to SyncClusterSnapshot for cluster %s: %v", snapProto.Cluster, err)
+		return
+	}
+	log.Printf("[Uploader] handleClusterSnapshot: SYNC cluster %s (pods=%d, services=%d, nodes=%d, chunks=%d) => Operator resp=%v",
+		snapProto.Cluster, len(snapProto.Pods), len(snapProto.Services), len(snapProto.Nodes), len(chunks), resp)
+}
+
+// snapshotChunkSize is the size (in bytes) of the objects in a snapshot chunk, well below the 4MB gRPC limit
+const snapshotChunkSize = 1 << 20
+
+// splitClusterSnapshot Function that splits a snapshot into chunks that share its cluster and timestamp
+// (an empty snapshot is still sent as one chunk, so that Operator removes what the cluster no longer has)
+func splitClusterSnapshot(snapshot *protobuf.ClusterSnapshot) []*protobuf.ClusterSnapshot {
+	newChunk := func() *protobuf.ClusterSnapshot {
+		return &protobuf.ClusterSnapshot{Cluster: snapshot.Cluster, TimeStamp: snapshot.TimeStamp}
+	}
+
+	chunks := []*protobuf.ClusterSnapshot{newChunk()}
+	size := 0
+
+	snapshot.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if !field.IsList() || field.Message() == nil {
+			return true
+		}
+
+		objects := value.List()
+		for i := 0; i < objects.Len(); i++ {
+			objSize := proto.Size(objects.Get(i).Message().Interface())
+			if size > 0 && size+objSize > snapshotChunkSize {
+				chunks = append(chunks, newChunk())
+				size = 0
+			}
+
+			chunk := chunks[len(chunks)-1].ProtoReflect()
+			chunk.Mutable(field).List().Append(objects.Get(i))
+			size += objSize
+		}
+		return true
+	})
+
+	return chunks
+}
+
 // == //
 
 // convertPodToProto Function
-func convertPodToProto(pod *corev1.Pod) *protobuf.Pod {
+func convertPodToProto(cluster string, pod *corev1.Pod) *protobuf.Pod {
 	return &protobuf.Pod{
-		Cluster:           config.GlobalConfig.ClusterName,
+		Cluster:           cluster,
 		Namespace:         pod.Namespace,
 		Name:              pod.Name,
 		NodeName:          pod.Spec.NodeName,
@@ -247,7 +789,7 @@ func convertPodToProto(pod *corev1.Pod) *protobuf.Pod {
 }
 
 // convertServiceToProto Function
-func convertServiceToProto(svc *corev1.Service) *protobuf.Service {
+func convertServiceToProto(cluster string, svc *corev1.Service) *protobuf.Service {
 	var protoPorts []*protobuf.Port
 	for _, p := range svc.Spec.Ports {
 		protoPorts = append(protoPorts, &protobuf.Port{
@@ -272,7 +814,7 @@ func convertServiceToProto(svc *corev1.Service) *protobuf.Service {
 	}
 
 	return &protobuf.Service{
-		Cluster:         config.GlobalConfig.ClusterName,
+		Cluster:         cluster,
 		Namespace:       svc.Namespace,
 		Name:            svc.Name,
 		Type:            string(svc.Spec.Type),
@@ -281,17 +823,18 @@ func convertServiceToProto(svc *corev1.Service) *protobuf.Service {
 		Labels:          svc.Labels,
 		ExternalIPs:     externalIPs,
 		LoadBalancerIPs: lbIngresses,
+		Selector:        svc.Spec.Selector,
 	}
 }
 
 // convertDeploymentToProto Function
-func convertDeploymentToProto(dep *appsv1.Deployment) *protobuf.Deploy {
+func convertDeploymentToProto(cluster string, dep *appsv1.Deployment) *protobuf.Deploy {
 	replicas := int32(0)
 	if dep.Spec.Replicas != nil {
 		replicas = int32(*dep.Spec.Replicas)
 	}
 	return &protobuf.Deploy{
-		Cluster:           config.GlobalConfig.ClusterName,
+		Cluster:           cluster,
 		Namespace:         dep.Namespace,
 		Name:              dep.Name,
 		DesiredReplicas:   replicas,
@@ -301,4 +844,264 @@ func convertDeploymentToProto(dep *appsv1.Deployment) *protobuf.Deploy {
 	}
 }
 
+// convertStatefulSetToProto Function
+func convertStatefulSetToProto(cluster string, sts *appsv1.StatefulSet) *protobuf.StatefulSet {
+	replicas := int32(0)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	return &protobuf.StatefulSet{
+		Cluster:           cluster,
+		Namespace:         sts.Namespace,
+		Name:              sts.Name,
+		DesiredReplicas:   replicas,
+		ReadyReplicas:     sts.Status.ReadyReplicas,
+		Labels:            sts.Labels,
+		CreationTimestamp: sts.CreationTimestamp.String(),
+		ServiceName:       sts.Spec.ServiceName,
+	}
+}
+
+// convertDaemonSetToProto Function
+func convertDaemonSetToProto(cluster string, ds *appsv1.DaemonSet) *protobuf.DaemonSet {
+	return &protobuf.DaemonSet{
+		Cluster:           cluster,
+		Namespace:         ds.Namespace,
+		Name:              ds.Name,
+		DesiredScheduled:  ds.Status.DesiredNumberScheduled,
+		ReadyScheduled:    ds.Status.NumberReady,
+		Labels:            ds.Labels,
+		CreationTimestamp: ds.CreationTimestamp.String(),
+	}
+}
+
+// convertJobToProto Function
+func convertJobToProto(cluster string, job *batchv1.Job) *protobuf.Job {
+	completions := int32(0)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+
+	// Jobs spawned by a CronJob carry it as their controller
+	cronJob := ""
+	for _, owner := range job.OwnerReferences {
+		if owner.Kind == "CronJob" {
+			cronJob = owner.Name
+			break
+		}
+	}
+
+	startTime := ""
+	if job.Status.StartTime != nil {
+		startTime = job.Status.StartTime.String()
+	}
+
+	completionTime := ""
+	if job.Status.CompletionTime != nil {
+		completionTime = job.Status.CompletionTime.String()
+	}
+
+	return &protobuf.Job{
+		Cluster:           cluster,
+		Namespace:         job.Namespace,
+		Name:              job.Name,
+		Completions:       completions,
+		Active:            job.Status.Active,
+		Succeeded:         job.Status.Succeeded,
+		Failed:            job.Status.Failed,
+		Labels:            job.Labels,
+		CreationTimestamp: job.CreationTimestamp.String(),
+		CronJob:           cronJob,
+		StartTime:         startTime,
+		CompletionTime:    completionTime,
+	}
+}
+
+// convertCronJobToProto Function
+func convertCronJobToProto(cluster string, cj *batchv1.CronJob) *protobuf.CronJob {
+	suspend := false
+	if cj.Spec.Suspend != nil {
+		suspend = *cj.Spec.Suspend
+	}
+
+	lastScheduleTime := ""
+	if cj.Status.LastScheduleTime != nil {
+		lastScheduleTime = cj.Status.LastScheduleTime.String()
+	}
+
+	return &protobuf.CronJob{
+		Cluster:           cluster,
+		Namespace:         cj.Namespace,
+		Name:              cj.Name,
+		Schedule:          cj.Spec.Schedule,
+		Suspend:           suspend,
+		ActiveJobs:        int32(len(cj.Status.Active)),
+		Labels:            cj.Labels,
+		CreationTimestamp: cj.CreationTimestamp.String(),
+		LastScheduleTime:  lastScheduleTime,
+	}
+}
+
+// convertNodeToProto Function
+func convertNodeToProto(cluster string, node *corev1.Node) *protobuf.Node {
+	internalIP := ""
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == corev1.NodeInternalIP {
+			internalIP = addr.Address
+			break
+		}
+	}
+
+	ready := false
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			ready = cond.Status == corev1.ConditionTrue
+			break
+		}
+	}
+
+	return &protobuf.Node{
+		Cluster:           cluster,
+		Name:              node.Name,
+		Labels:            node.Labels,
+		Zone:              types.LookupLabel(node.Labels, types.LabelTopologyZone, types.LabelBetaTopologyZone),
+		Region:            types.LookupLabel(node.Labels, types.LabelTopologyRegion, types.LabelBetaTopologyRegion),
+		InstanceType:      types.LookupLabel(node.Labels, types.LabelInstanceType, types.LabelBetaInstanceType),
+		CapacityCPU:       node.Status.Capacity.Cpu().String(),
+		CapacityMemory:    node.Status.Capacity.Memory().String(),
+		AllocatableCPU:    node.Status.Allocatable.Cpu().String(),
+		AllocatableMemory: node.Status.Allocatable.Memory().String(),
+		InternalIP:        internalIP,
+		Ready:             ready,
+		CreationTimestamp: node.CreationTimestamp.String(),
+	}
+}
+
+// convertNamespaceToProto Function
+func convertNamespaceToProto(cluster string, ns *corev1.Namespace) *protobuf.Namespace {
+	return &protobuf.Namespace{
+		Cluster:           cluster,
+		Name:              ns.Name,
+		Labels:            ns.Labels,
+		Annotations:       ns.Annotations,
+		IstioInjection:    ns.Labels[types.LabelIstioInjection],
+		Meshed:            types.IsNamespaceMeshed(ns.Labels),
+		Status:            string(ns.Status.Phase),
+		CreationTimestamp: ns.CreationTimestamp.String(),
+	}
+}
+
+// convertIngressToProto Function
+func convertIngressToProto(cluster string, ing *types.Ingress) *protobuf.Ingress {
+	rules := make([]*protobuf.IngressRule, 0, len(ing.Rules))
+	for _, rule := range ing.Rules {
+		rules = append(rules, &protobuf.IngressRule{
+			Host:             rule.Host,
+			Path:             rule.Path,
+			BackendNamespace: rule.BackendNamespace,
+			BackendService:   rule.BackendService,
+			BackendPort:      rule.BackendPort,
+		})
+	}
+
+	return &protobuf.Ingress{
+		Cluster:           cluster,
+		Namespace:         ing.Namespace,
+		Name:              ing.Name,
+		Kind:              ing.Kind,
+		ClassName:         ing.ClassName,
+		Hosts:             ing.Hosts,
+		Addresses:         ing.Addresses,
+		Gateways:          ing.Gateways,
+		Rules:             rules,
+		Labels:            ing.Labels,
+		CreationTimestamp: ing.CreationTimestamp,
+	}
+}
+
+// convertServiceEndpointsToProto Function
+func convertServiceEndpointsToProto(cluster string, se *types.ServiceEndpoints) *protobuf.ServiceEndpoints {
+	endpoints := make([]*protobuf.EndpointRef, 0, len(se.Endpoints))
+	for _, ep := range se.Endpoints {
+		endpoints = append(endpoints, &protobuf.EndpointRef{
+			Ip:           ep.IP,
+			PodNamespace: ep.PodNamespace,
+			PodName:      ep.PodName,
+			NodeName:     ep.NodeName,
+			Zone:         ep.Zone,
+			Ready:        ep.Ready,
+		})
+	}
+
+	return &protobuf.ServiceEndpoints{
+		Cluster:       cluster,
+		Namespace:     se.Namespace,
+		Name:          se.Name,
+		Endpoints:     endpoints,
+		ReadyCount:    se.ReadyCount,
+		NotReadyCount: se.NotReadyCount,
+	}
+}
+
+// convertClusterSnapshotToProto Function
+func convertClusterSnapshotToProto(cluster string, snapshot *types.ClusterSnapshot) *protobuf.ClusterSnapshot {
+	snapProto := &protobuf.ClusterSnapshot{
+		Cluster:   cluster,
+		TimeStamp: snapshot.BuiltAt.Format(time.RFC3339Nano),
+	}
+
+	for resourceType, objects := range snapshot.Objects {
+		for _, obj := range objects {
+			switch resourceType {
+			case "Pod":
+				if pod, ok := obj.(*corev1.Pod); ok {
+					snapProto.Pods = append(snapProto.Pods, convertPodToProto(cluster, pod))
+				}
+			case "Service":
+				if svc, ok := obj.(*corev1.Service); ok {
+					snapProto.Services = append(snapProto.Services, convertServiceToProto(cluster, svc))
+				}
+			case "Deploy":
+				if dep, ok := obj.(*appsv1.Deployment); ok {
+					snapProto.Deploys = append(snapProto.Deploys, convertDeploymentToProto(cluster, dep))
+				}
+			case "StatefulSet":
+				if sts, ok := obj.(*appsv1.StatefulSet); ok {
+					snapProto.StatefulSets = append(snapProto.StatefulSets, convertStatefulSetToProto(cluster, sts))
+				}
+			case "DaemonSet":
+				if ds, ok := obj.(*appsv1.DaemonSet); ok {
+					snapProto.DaemonSets = append(snapProto.DaemonSets, convertDaemonSetToProto(cluster, ds))
+				}
+			case "Job":
+				if job, ok := obj.(*batchv1.Job); ok {
+					snapProto.Jobs = append(snapProto.Jobs, convertJobToProto(cluster, job))
+				}
+			case "CronJob":
+				if cj, ok := obj.(*batchv1.CronJob); ok {
+					snapProto.CronJobs = append(snapProto.CronJobs, convertCronJobToProto(cluster, cj))
+				}
+			case "Node":
+				if node, ok := obj.(*corev1.Node); ok {
+					snapProto.Nodes = append(snapProto.Nodes, convertNodeToProto(cluster, node))
+				}
+			case "Namespace":
+				if ns, ok := obj.(*corev1.Namespace); ok {
+					snapProto.Namespaces = append(snapProto.Namespaces, convertNamespaceToProto(cluster, ns))
+				}
+			case "Ingress":
+				if ing, ok := obj.(*types.Ingress); ok {
+					snapProto.Ingresses = append(snapProto.Ingresses, convertIngressToProto(cluster, ing))
+				}
+			case "ServiceEndpoints":
+				if se, ok := obj.(*types.ServiceEndpoints); ok {
+					snapProto.ServiceEndpoints = append(snapProto.ServiceEndpoints, convertServiceEndpointsToProto(cluster, se))
+				}
+			}
+		}
+	}
+
+	return snapProto
+}
+
 // == //
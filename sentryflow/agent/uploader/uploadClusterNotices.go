@@ -0,0 +1,64 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// UploadClusterNotice Function (only the leader uploads cluster notices, like cluster events)
+func (upl *UplHandler) UploadClusterNotice(notice *protobuf.ClusterNotice) {
+	if !upl.clusterEventsEnabled.Load() {
+		return
+	}
+	upl.uploaderClusterNotices <- notice
+}
+
+// uploadClusterNotices Function
+func (upl *UplHandler) uploadClusterNotices(wg *sync.WaitGroup) {
+	wg.Add(1)
+
+	for {
+		select {
+		case notice, ok := <-upl.uploaderClusterNotices:
+			if !ok {
+				log.Printf("[Uploader] Failed to fetch cluster notices from cluster notices channel")
+				wg.Done()
+				return
+			}
+
+			if err := upl.sendClusterNotices(notice); err != nil {
+				log.Printf("[Uploader] Failed to upload cluster notices: %v", err)
+			}
+
+		case <-upl.stopChan:
+			wg.Done()
+			return
+		}
+	}
+}
+
+// sendClusterNotices Function
+func (upl *UplHandler) sendClusterNotices(notice *protobuf.ClusterNotice) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := upl.grpcClient.GiveClusterNotice(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open GiveClusterNotice stream: %w", err)
+	}
+
+	if err := stream.Send(notice); err != nil {
+		return fmt.Errorf("failed to send ClusterNotice: %w", err)
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("failed to close GiveClusterNotice stream: %w", err)
+	}
+	log.Printf("[Uploader] Cluster Notice sent, response: %v", resp)
+	return nil
+}
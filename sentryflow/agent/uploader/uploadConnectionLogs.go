@@ -0,0 +1,61 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// UploadConnectionLog Function
+func UploadConnectionLog(connLog *protobuf.ConnectionLog) {
+	UplH.uploaderConnectionLogs <- connLog
+}
+
+// uploadConnectionLogs Function
+func (upl *UplHandler) uploadConnectionLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+
+	for {
+		select {
+		case connLog, ok := <-upl.uploaderConnectionLogs:
+			if !ok {
+				log.Printf("[Uploader] Failed to fetch connection logs from connection logs channel")
+				wg.Done()
+				return
+			}
+
+			if err := upl.sendConnectionLogs(connLog); err != nil {
+				log.Printf("[Uploader] Failed to upload connection logs: %v", err)
+			}
+
+		case <-upl.stopChan:
+			wg.Done()
+			return
+		}
+	}
+}
+
+// sendConnectionLogs Function
+func (upl *UplHandler) sendConnectionLogs(connLog *protobuf.ConnectionLog) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := upl.grpcClient.GiveConnectionLog(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open GiveConnectionLog stream: %w", err)
+	}
+
+	if err := stream.Send(connLog); err != nil {
+		return fmt.Errorf("failed to send ConnectionLog: %w", err)
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("failed to close GiveConnectionLog stream: %w", err)
+	}
+	log.Printf("[Uploader] Connection Log sent, response: %v", resp)
+	return nil
+}
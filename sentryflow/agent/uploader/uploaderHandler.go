@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"sync/atomic"
 
 	"Agent/config"
 	"Agent/types"
@@ -13,7 +14,6 @@ import (
 	"github.com/Jitria/SentryFlow/protobuf"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // == //
@@ -30,9 +30,16 @@ func init() {
 type UplHandler struct {
 	grpcClient protobuf.SentryFlowClient
 
-	uploaderAPILogs      chan *protobuf.APILog
-	uploaderEnovyMetrics chan *protobuf.EnvoyMetrics
-	clusterEvents        chan *types.ClusterEvent
+	uploaderAPILogs        chan *protobuf.APILog
+	uploaderEnovyMetrics   chan *protobuf.EnvoyMetrics
+	uploaderConnectionLogs chan *protobuf.ConnectionLog
+	uploaderAuditLogs      chan *protobuf.AuditLog
+	uploaderClusterNotices chan *protobuf.ClusterNotice
+	clusterEvents          chan *types.ClusterEvent
+
+	clusterEventsEnabled atomic.Bool // only the leader uploads cluster events
+
+	watchedClusters chan []string // clusters to register with, known once the kubeconfig is loaded
 
 	stopChan chan struct{}
 }
@@ -40,9 +47,14 @@ type UplHandler struct {
 // NewUploaderHandler Function
 func NewUploaderHandler() *UplHandler {
 	ch := &UplHandler{
-		uploaderAPILogs:      make(chan *protobuf.APILog),
-		uploaderEnovyMetrics: make(chan *protobuf.EnvoyMetrics),
-		clusterEvents:        make(chan *types.ClusterEvent),
+		uploaderAPILogs:        make(chan *protobuf.APILog),
+		uploaderEnovyMetrics:   make(chan *protobuf.EnvoyMetrics),
+		uploaderConnectionLogs: make(chan *protobuf.ConnectionLog),
+		uploaderAuditLogs:      make(chan *protobuf.AuditLog),
+		uploaderClusterNotices: make(chan *protobuf.ClusterNotice),
+		clusterEvents:          make(chan *types.ClusterEvent),
+
+		watchedClusters: make(chan []string, 1),
 
 		stopChan: make(chan struct{}),
 	}
@@ -72,13 +84,35 @@ func StartUploader(wg *sync.WaitGroup) bool {
 	go UplH.uploadEnvoyMetrics(wg)
 	log.Printf("[Uploader] Exporting Envoy metrics through gRPC services")
 
+	// Export ConnectionLogs
+	go UplH.uploadConnectionLogs(wg)
+	log.Printf("[Uploader] Exporting connection logs through gRPC services")
+
+	// Export AuditLogs
+	go UplH.uploadAuditLogs(wg)
+	log.Printf("[Uploader] Exporting audit logs through gRPC services")
+
+	// Export ClusterNotices
+	go UplH.uploadClusterNotices(wg)
+	log.Printf("[Uploader] Exporting cluster notices through gRPC services")
+
+	// Register with Operator and send heartbeats
+	go UplH.runHeartbeats(wg)
+	log.Printf("[Uploader] Sending heartbeats to Operator every %d seconds", config.GlobalConfig.HeartbeatPeriod)
+
 	return true
 }
 
 func connectToOperator() (protobuf.SentryFlowClient, error) {
 	operatorAddr := fmt.Sprintf("%s:%s", config.GlobalConfig.OperatorAddr, config.GlobalConfig.OperatorPort)
 
-	conn, err := grpc.NewClient(operatorAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts, err := operatorDialOptions()
+	if err != nil {
+		log.Printf("[Uploader] Failed to configure the connection to Operator: %v", err)
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(operatorAddr, opts...)
 	if err != nil {
 		log.Printf("[Uploader] Failed to connect to Operator's gRPC server at %s: %v", operatorAddr, err)
 		return nil, nil
@@ -89,6 +123,11 @@ func connectToOperator() (protobuf.SentryFlowClient, error) {
 	return client, nil
 }
 
+// EnableClusterEvents Function that turns the upload of cluster events on or off
+func (upl *UplHandler) EnableClusterEvents(enabled bool) {
+	upl.clusterEventsEnabled.Store(enabled)
+}
+
 // == //
 
 // StopUploader Function
@@ -102,6 +141,18 @@ func StopUploader() bool {
 	// One for uploadEnvoyMetrics
 	UplH.stopChan <- struct{}{}
 
+	// One for uploadConnectionLogs
+	UplH.stopChan <- struct{}{}
+
+	// One for uploadAuditLogs
+	UplH.stopChan <- struct{}{}
+
+	// One for uploadClusterNotices
+	UplH.stopChan <- struct{}{}
+
+	// One for runHeartbeats
+	UplH.stopChan <- struct{}{}
+
 	return true
 }
 
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"Operator/exporter"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// == //
+
+// agentIDMetadataKey is the gRPC metadata key that identifies the agent making a call
+const agentIDMetadataKey = "sentryflow-agent-id"
+
+// RegisterAgent Function
+// (with agent authentication, an agent ID belongs to the credential that registered it first)
+func (cs *ColService) RegisterAgent(ctx context.Context, info *protobuf.AgentInfo) (*protobuf.Response, error) {
+	if cred := agentCredential(ctx); cred != nil {
+		for _, cluster := range append([]string{info.Cluster}, info.Clusters...) {
+			if !cred.allows(cluster) {
+				auditRejection(ctx, "RegisterAgent", cred, fmt.Errorf("cluster %q is not allowed", cluster))
+				return nil, status.Errorf(codes.PermissionDenied, "cluster %q is not allowed", cluster)
+			}
+		}
+	}
+	if agentID := callingAgentID(ctx); agentID != "" && agentID != info.AgentID {
+		return nil, status.Errorf(codes.InvalidArgument, "agent %q registers as %q", agentID, info.AgentID)
+	}
+
+	// Agents that cannot tell their own address are reached at the address they connect from
+	if info.IPAddress == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			info.IPAddress = p.Addr.String()
+		}
+	}
+
+	if err := exporter.RegisterAgent(info, agentOwner(ctx)); err != nil {
+		auditRejection(ctx, "RegisterAgent", agentCredential(ctx), err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// Heartbeat Function (Msg is 1 when the agent is not registered, so that it registers again)
+func (cs *ColService) Heartbeat(ctx context.Context, heartbeat *protobuf.AgentHeartbeat) (*protobuf.Response, error) {
+	registered, err := exporter.RecordAgentHeartbeat(heartbeat, agentOwner(ctx))
+	if err != nil {
+		auditRejection(ctx, "Heartbeat", agentCredential(ctx), err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if !registered {
+		log.Printf("[Operator] Heartbeat: unknown agent %s (cluster=%s), asking it to register", heartbeat.AgentID, heartbeat.Cluster)
+		return &protobuf.Response{Msg: 1}, nil
+	}
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// == //
+
+// callingAgentID Function that returns the agent ID that a call claims
+func callingAgentID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(agentIDMetadataKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// callingAgent Function that returns the identity of the registered agent making a call
+// (the claimed ID counts only if the agent was registered with the credential of the call)
+func callingAgent(ctx context.Context) string {
+	agentID := callingAgentID(ctx)
+	if agentID == "" || !exporter.AgentOwnedBy(agentID, agentOwner(ctx)) {
+		return ""
+	}
+	return agentID
+}
+
+// reportingCluster Function that returns the cluster of the registered agent making a call
+func reportingCluster(ctx context.Context) string {
+	if agentID := callingAgent(ctx); agentID != "" {
+		if cluster, ok := exporter.AgentCluster(agentID); ok {
+			return cluster
+		}
+	}
+	return ""
+}
+
+// trackUnaryInterceptor Function that records the data sent by an agent on a unary call
+func trackUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	switch req.(type) {
+	case *protobuf.AgentInfo, *protobuf.AgentHeartbeat:
+	default:
+		if agentID := callingAgent(ctx); agentID != "" {
+			exporter.RecordAgentData(agentID)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// trackStreamInterceptor Function that records the data sent by an agent on a stream
+func trackStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	agentID := callingAgent(ss.Context())
+	if agentID == "" {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &trackServerStream{ServerStream: ss, agentID: agentID})
+}
+
+// trackServerStream Structure that records every message received on a stream
+type trackServerStream struct {
+	grpc.ServerStream
+
+	agentID string
+}
+
+// RecvMsg Function
+func (s *trackServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	exporter.RecordAgentData(s.agentID)
+	return nil
+}
+
+// == //
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+// apiLogReport Structure that keeps the report of a sidecar waiting for the report of the other end
+type apiLogReport struct {
+	apiLog  *protobuf.APILog
+	key     string
+	arrived time.Time
+	paired  bool
+}
+
+// APILogDeduplicator Structure that pairs the client-side and server-side reports of a request
+// (only ProcessAPILogs uses it, so it needs no lock)
+type APILogDeduplicator struct {
+	window time.Duration
+
+	queue       []*apiLogReport            // pending reports in arrival order
+	byRequestID map[string][]*apiLogReport // key: request ID (hops of a call chain may share one)
+	byKey       map[string][]*apiLogReport // key: source, destination, method, path and response code
+}
+
+// NewAPILogDeduplicator Function
+func NewAPILogDeduplicator(window time.Duration) *APILogDeduplicator {
+	return &APILogDeduplicator{
+		window: window,
+
+		queue:       make([]*apiLogReport, 0),
+		byRequestID: make(map[string][]*apiLogReport),
+		byKey:       make(map[string][]*apiLogReport),
+	}
+}
+
+// == //
+
+// dedupKey Function that identifies a request by what both of its ends see alike
+// (addresses and ports differ between the two reports, the workloads do not; the client sees the
+// service it called and the server the pod behind it, so the destination is the service that
+// JoinServiceEndpoints found at both ends, or the pod when it backs no service or several)
+func dedupKey(apiLog *protobuf.APILog) string {
+	dstName := apiLog.DstService
+	if dstName == "" {
+		dstName = apiLog.DstName
+	}
+
+	return fmt.Sprintf("%s/%s/%s|%s/%s/%s|%s|%s|%d",
+		apiLog.SrcCluster, apiLog.SrcNamespace, apiLog.SrcName,
+		apiLog.DstCluster, apiLog.DstNamespace, dstName,
+		apiLog.Method, apiLog.Path, apiLog.ResponseCode)
+}
+
+// counterpart Function that checks if two reports come from the opposite ends of the same request
+func (dd *APILogDeduplicator) counterpart(report *apiLogReport, apiLog *protobuf.APILog) bool {
+	if report.paired || report.apiLog.ReportedBy == apiLog.ReportedBy {
+		return false
+	}
+
+	diff := callStart(report.apiLog).Sub(callStart(apiLog))
+	if diff < 0 {
+		diff = -diff
+	}
+
+	// Logs without a start time only have seconds, so a second of slack is allowed on top of the window
+	return diff <= dd.window+time.Second
+}
+
+// Add Function that returns the log to export now, or nil while a report waits for its counterpart
+func (dd *APILogDeduplicator) Add(apiLog *protobuf.APILog, now time.Time) *protobuf.APILog {
+	// Only sidecars report a request from both ends
+	if apiLog.ReportedBy != "client" && apiLog.ReportedBy != "server" {
+		return apiLog
+	}
+
+	key := dedupKey(apiLog)
+
+	// The request ID is the same at both ends when the application propagates it, but also the same
+	// for every hop of the call chain, so only the report of the same call from the other end is taken
+	if apiLog.RequestID != "" {
+		for _, report := range dd.byRequestID[apiLog.RequestID] {
+			if report.key == key && dd.counterpart(report, apiLog) {
+				report.paired = true
+				return mergeAPILogs(report.apiLog, apiLog)
+			}
+		}
+	}
+
+	// Otherwise the oldest pending report of the same request from the other end is taken
+	for _, report := range dd.byKey[key] {
+		if dd.counterpart(report, apiLog) && (report.apiLog.RequestID == "" || apiLog.RequestID == "") {
+			report.paired = true
+			return mergeAPILogs(report.apiLog, apiLog)
+		}
+	}
+
+	report := &apiLogReport{apiLog: apiLog, key: key, arrived: now}
+
+	dd.queue = append(dd.queue, report)
+	dd.byKey[key] = append(dd.byKey[key], report)
+	if apiLog.RequestID != "" {
+		dd.byRequestID[apiLog.RequestID] = append(dd.byRequestID[apiLog.RequestID], report)
+	}
+
+	return nil
+}
+
+// Expire Function that returns the reports whose counterpart did not arrive within the window
+func (dd *APILogDeduplicator) Expire(now time.Time) []*protobuf.APILog {
+	expired := make([]*protobuf.APILog, 0)
+
+	idx := 0
+	for ; idx < len(dd.queue); idx++ {
+		report := dd.queue[idx]
+		if now.Sub(report.arrived) < dd.window {
+			break
+		}
+
+		// Reports that only one end saw keep their side in reportedBy
+		if !report.paired {
+			expired = append(expired, report.apiLog)
+		}
+
+		dd.forget(report)
+	}
+
+	dd.queue = dd.queue[idx:]
+
+	return expired
+}
+
+// forget Function that removes a report from the indexes
+func (dd *APILogDeduplicator) forget(report *apiLogReport) {
+	if id := report.apiLog.RequestID; id != "" {
+		removeReport(dd.byRequestID, id, report)
+	}
+
+	removeReport(dd.byKey, report.key, report)
+}
+
+// removeReport Function that removes a report from the list of an index
+func removeReport(index map[string][]*apiLogReport, key string, report *apiLogReport) {
+	reports := index[key]
+	for idx, r := range reports {
+		if r == report {
+			reports = append(reports[:idx], reports[idx+1:]...)
+			break
+		}
+	}
+
+	if len(reports) == 0 {
+		delete(index, key)
+	} else {
+		index[key] = reports
+	}
+}
+
+// == //
+
+// mergeAPILogs Function that merges the client-side and server-side reports of a request
+// (the client knows the real caller, the server knows the pod that served the request)
+func mergeAPILogs(first, second *protobuf.APILog) *protobuf.APILog {
+	client, server := first, second
+	if first.ReportedBy == "server" {
+		client, server = second, first
+	}
+
+	merged := proto.Clone(client).(*protobuf.APILog)
+
+	if merged.SrcCluster == "Unknown" || merged.SrcType == "Unknown" {
+		merged.SrcCluster = server.SrcCluster
+		merged.SrcNamespace = server.SrcNamespace
+		merged.SrcName = server.SrcName
+		merged.SrcLabel = server.SrcLabel
+		merged.SrcType = server.SrcType
+		merged.SrcZone = server.SrcZone
+	}
+
+	if server.DstType == "Pod" {
+		if merged.DstService == "" && merged.DstType == "Service" {
+			merged.DstService = merged.DstName
+		}
+
+		merged.DstCluster = server.DstCluster
+		merged.DstNamespace = server.DstNamespace
+		merged.DstName = server.DstName
+		merged.DstLabel = server.DstLabel
+		merged.DstType = server.DstType
+		merged.DstZone = server.DstZone
+		merged.DstPod = server.DstName
+		if merged.DstService == "" {
+			merged.DstService = server.DstService
+		}
+	}
+
+	if merged.RequestID == "" {
+		merged.RequestID = server.RequestID
+	}
+
+	merged.ServerLatency = server.ServerLatency
+	merged.ReportedBy = "both"
+
+	return merged
+}
+
+// == //
@@ -0,0 +1,323 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"Operator/config"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// == //
+
+// AgentCredential Structure that maps an agent credential to the clusters it may report
+type AgentCredential struct {
+	Name     string   `json:"name"`               // name of the agent (for auditing)
+	Token    string   `json:"token,omitempty"`    // bearer token
+	Identity string   `json:"identity,omitempty"` // client certificate identity (CN, DNS or URI SAN)
+	Clusters []string `json:"clusters"`           // cluster names the agent may report
+}
+
+// agentCredentialFile Structure
+type agentCredentialFile struct {
+	Agents []AgentCredential `json:"agents"`
+}
+
+// AgentAuthenticator Structure
+type AgentAuthenticator struct {
+	credentials []AgentCredential
+}
+
+// unknownCluster is reported for traffic endpoints that could not be resolved
+const unknownCluster = "Unknown"
+
+// agentCredentialKey is the context key of the credential that authenticated a call
+type agentCredentialKey struct{}
+
+// agentCredential Function that returns the credential that authenticated a call (nil without agent authentication)
+func agentCredential(ctx context.Context) *AgentCredential {
+	cred, _ := ctx.Value(agentCredentialKey{}).(*AgentCredential)
+	return cred
+}
+
+// agentOwner Function that returns the name of the credential that authenticated a call
+// (empty without agent authentication)
+func agentOwner(ctx context.Context) string {
+	if cred := agentCredential(ctx); cred != nil {
+		return cred.Name
+	}
+	return ""
+}
+
+// LoadAgentCredentials Function that reads the agent credentials from a JSON file
+func LoadAgentCredentials(path string) (*AgentAuthenticator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	credFile := agentCredentialFile{}
+	if err := json.Unmarshal(data, &credFile); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	for idx, cred := range credFile.Agents {
+		if cred.Token == "" && cred.Identity == "" {
+			return nil, fmt.Errorf("agent %d (%s) has neither a token nor an identity", idx, cred.Name)
+		}
+		if len(cred.Clusters) == 0 {
+			return nil, fmt.Errorf("agent %d (%s) is not allowed any cluster", idx, cred.Name)
+		}
+	}
+
+	return &AgentAuthenticator{credentials: credFile.Agents}, nil
+}
+
+// authenticate Function that returns the credential presented on a call
+func (auth *AgentAuthenticator) authenticate(ctx context.Context) (*AgentCredential, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			token, found := strings.CutPrefix(value, "Bearer ")
+			if !found {
+				continue
+			}
+
+			for idx := range auth.credentials {
+				cred := &auth.credentials[idx]
+				if cred.Token != "" && subtle.ConstantTimeCompare([]byte(cred.Token), []byte(token)) == 1 {
+					return cred, nil
+				}
+			}
+
+			return nil, errors.New("unknown bearer token")
+		}
+	}
+
+	for _, identity := range peerCertIdentities(ctx) {
+		for idx := range auth.credentials {
+			cred := &auth.credentials[idx]
+			if cred.Identity != "" && cred.Identity == identity {
+				return cred, nil
+			}
+		}
+	}
+
+	return nil, errors.New("no valid credential")
+}
+
+// peerCertIdentities Function that returns the identities of a verified client certificate
+func peerCertIdentities(ctx context.Context) []string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	cert := tlsInfo.State.VerifiedChains[0][0]
+
+	identities := []string{}
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+	identities = append(identities, cert.DNSNames...)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	return identities
+}
+
+// allows Function that checks if a credential may report a cluster
+func (cred *AgentCredential) allows(cluster string) bool {
+	for _, allowed := range cred.Clusters {
+		if allowed == "*" || allowed == cluster {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize Function that checks the cluster names carried by a message
+func (cred *AgentCredential) authorize(msg interface{}) error {
+	switch m := msg.(type) {
+	// Cluster objects, snapshots, notices and audit logs belong to a single cluster
+	case interface{ GetCluster() string }:
+		if !cred.allows(m.GetCluster()) {
+			return fmt.Errorf("cluster %q is not allowed", m.GetCluster())
+		}
+
+	// Traffic is reported by the cluster of one of its ends, the other end may be anywhere
+	case interface {
+		GetSrcCluster() string
+		GetDstCluster() string
+	}:
+		src, dst := m.GetSrcCluster(), m.GetDstCluster()
+		if cred.allows(src) || cred.allows(dst) {
+			return nil
+		}
+		if (src == "" || src == unknownCluster) && (dst == "" || dst == unknownCluster) {
+			return nil
+		}
+		return fmt.Errorf("clusters %q and %q are not allowed", src, dst)
+	}
+
+	return nil
+}
+
+// auditRejection Function that records a rejected submission
+func auditRejection(ctx context.Context, method string, cred *AgentCredential, reason error) {
+	addr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+
+	agent := "unauthenticated"
+	if cred != nil {
+		agent = cred.Name
+	}
+
+	log.Printf("[AgentAuth] Rejected %s from %s (agent=%s): %v", method, addr, agent, reason)
+}
+
+// == //
+
+// unaryInterceptor Function that authenticates a unary call and authorizes its request
+func (auth *AgentAuthenticator) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	cred, err := auth.authenticate(ctx)
+	if err != nil {
+		auditRejection(ctx, info.FullMethod, nil, err)
+		return nil, status.Error(codes.Unauthenticated, "agent authentication failed")
+	}
+
+	if err := cred.authorize(req); err != nil {
+		auditRejection(ctx, info.FullMethod, cred, err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return handler(context.WithValue(ctx, agentCredentialKey{}, cred), req)
+}
+
+// streamInterceptor Function that authenticates a stream and authorizes every message it receives
+func (auth *AgentAuthenticator) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	cred, err := auth.authenticate(ss.Context())
+	if err != nil {
+		auditRejection(ss.Context(), info.FullMethod, nil, err)
+		return status.Error(codes.Unauthenticated, "agent authentication failed")
+	}
+
+	return handler(srv, &authServerStream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), agentCredentialKey{}, cred),
+		cred:         cred,
+		method:       info.FullMethod,
+	})
+}
+
+// authServerStream Structure that authorizes the messages received on a stream
+type authServerStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context // carries the credential
+	cred   *AgentCredential
+	method string
+}
+
+// Context Function
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg Function
+func (s *authServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if err := s.cred.authorize(m); err != nil {
+		auditRejection(s.Context(), s.method, s.cred, err)
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return nil
+}
+
+// == //
+
+// collectorServerOptions Function that returns the TLS, authentication and tracking options of Collector gRPC
+func collectorServerOptions() ([]grpc.ServerOption, error) {
+	opts := []grpc.ServerOption{}
+
+	if config.GlobalConfig.CollectorTLSCert != "" {
+		cert, err := tls.LoadX509KeyPair(config.GlobalConfig.CollectorTLSCert, config.GlobalConfig.CollectorTLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the TLS key pair: %w", err)
+		}
+
+		tlsConfig := &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+
+		// Agents may present a client certificate instead of a bearer token
+		if config.GlobalConfig.CollectorTLSCA != "" {
+			caPEM, err := os.ReadFile(config.GlobalConfig.CollectorTLSCA)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the client CA: %w", err)
+			}
+
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caPEM) {
+				return nil, errors.New("no certificate found in the client CA")
+			}
+
+			tlsConfig.ClientCAs = pool
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	if config.GlobalConfig.AgentAuth {
+		auth, err := LoadAgentCredentials(config.GlobalConfig.AgentCredentials)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load agent credentials: %w", err)
+		}
+
+		log.Printf("[AgentAuth] Loaded credentials of %d agents", len(auth.credentials))
+
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(auth.unaryInterceptor),
+			grpc.ChainStreamInterceptor(auth.streamInterceptor),
+		)
+	}
+
+	// Track the data of registered agents (after authentication, so rejected calls are not counted)
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(trackUnaryInterceptor),
+		grpc.ChainStreamInterceptor(trackStreamInterceptor),
+	)
+
+	return opts, nil
+}
+
+// == //
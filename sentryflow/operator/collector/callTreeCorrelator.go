@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// maxCallTreeSpans bounds a call tree
+// (a client that reuses its request ID would otherwise keep a tree open forever)
+const maxCallTreeSpans = 1000
+
+// callTreeSlack absorbs the clock differences between the proxies that report the calls of a tree
+const callTreeSlack = 100 * time.Millisecond
+
+// pendingCallTree Structure that keeps the API logs of a request until no more calls arrive
+type pendingCallTree struct {
+	traceID   string
+	requestID string
+
+	apiLogs  []*protobuf.APILog
+	lastSeen time.Time
+}
+
+// CallTreeCorrelator Structure that groups API logs by trace ID (or request ID) into call trees
+// (only ProcessAPILogs uses it, so it needs no lock)
+type CallTreeCorrelator struct {
+	timeout time.Duration
+
+	byTraceID   map[string]*pendingCallTree // key: trace ID
+	byRequestID map[string]*pendingCallTree // key: request ID
+}
+
+// NewCallTreeCorrelator Function
+func NewCallTreeCorrelator(timeout time.Duration) *CallTreeCorrelator {
+	return &CallTreeCorrelator{
+		timeout: timeout,
+
+		byTraceID:   make(map[string]*pendingCallTree),
+		byRequestID: make(map[string]*pendingCallTree),
+	}
+}
+
+// == //
+
+// Add Function that puts an API log into the call tree of its request
+// (logs that carry neither a trace ID nor a request ID cannot be correlated)
+func (cc *CallTreeCorrelator) Add(apiLog *protobuf.APILog, now time.Time) {
+	if apiLog.TraceID == "" && apiLog.RequestID == "" {
+		return
+	}
+
+	// Proxies that do not log traceparent still share the request ID with the ones that do
+	// (a request ID seen again in another trace starts a new tree)
+	tree, found := cc.byTraceID[apiLog.TraceID]
+	if !found {
+		tree, found = cc.byRequestID[apiLog.RequestID]
+		if !found || (tree.traceID != "" && apiLog.TraceID != "") {
+			tree = &pendingCallTree{}
+		}
+	}
+
+	if tree.traceID == "" && apiLog.TraceID != "" {
+		tree.traceID = apiLog.TraceID
+		cc.byTraceID[tree.traceID] = tree
+	}
+	if tree.requestID == "" && apiLog.RequestID != "" {
+		tree.requestID = apiLog.RequestID
+		cc.byRequestID[tree.requestID] = tree
+	}
+
+	tree.apiLogs = append(tree.apiLogs, apiLog)
+	tree.lastSeen = now
+}
+
+// Expire Function that returns the call trees that received no API log within the timeout
+func (cc *CallTreeCorrelator) Expire(now time.Time) []*protobuf.CallTree {
+	expired := make([]*pendingCallTree, 0)
+
+	for _, tree := range cc.byTraceID {
+		if now.Sub(tree.lastSeen) >= cc.timeout || len(tree.apiLogs) >= maxCallTreeSpans {
+			expired = append(expired, tree)
+		}
+	}
+	for _, tree := range cc.byRequestID {
+		if tree.traceID == "" && (now.Sub(tree.lastSeen) >= cc.timeout || len(tree.apiLogs) >= maxCallTreeSpans) {
+			expired = append(expired, tree)
+		}
+	}
+
+	callTrees := make([]*protobuf.CallTree, 0, len(expired))
+	for _, tree := range expired {
+		if cc.byTraceID[tree.traceID] == tree {
+			delete(cc.byTraceID, tree.traceID)
+		}
+		if cc.byRequestID[tree.requestID] == tree {
+			delete(cc.byRequestID, tree.requestID)
+		}
+
+		callTrees = append(callTrees, buildCallTree(tree))
+	}
+
+	// Trees are exported in the order in which they started
+	sort.Slice(callTrees, func(i, j int) bool {
+		return callTrees[i].StartTime < callTrees[j].StartTime
+	})
+
+	return callTrees
+}
+
+// == //
+
+// buildCallTree Function that links every call of a request to the call that caused it
+// (a call made by a workload belongs to the latest call into that workload that was still running)
+func buildCallTree(tree *pendingCallTree) *protobuf.CallTree {
+	apiLogs := tree.apiLogs
+	sort.SliceStable(apiLogs, func(i, j int) bool {
+		return callStart(apiLogs[i]).Before(callStart(apiLogs[j]))
+	})
+
+	// Requests without a traceparent get a trace ID derived from their request ID
+	traceID := tree.traceID
+	if traceID == "" {
+		sum := sha256.Sum256([]byte(tree.requestID))
+		traceID = hex.EncodeToString(sum[:16])
+	}
+
+	callTree := &protobuf.CallTree{
+		TraceID:   traceID,
+		RequestID: tree.requestID,
+		Spans:     make([]*protobuf.CallSpan, 0, len(apiLogs)),
+	}
+
+	var first, last time.Time
+
+	for idx, apiLog := range apiLogs {
+		start := callStart(apiLog)
+		end := start.Add(time.Duration(callDuration(apiLog)))
+
+		span := &protobuf.CallSpan{
+			SpanID:    newCallSpanID(),
+			StartTime: start.UnixNano(),
+			Duration:  callDuration(apiLog),
+			ApiLog:    apiLog,
+		}
+
+		for parent := idx - 1; parent >= 0; parent-- {
+			if callsInto(apiLogs[parent], apiLog) && runningAt(apiLogs[parent], start) {
+				span.ParentSpanID = callTree.Spans[parent].SpanID
+				break
+			}
+		}
+
+		callTree.Spans = append(callTree.Spans, span)
+
+		if idx == 0 || start.Before(first) {
+			first = start
+		}
+		if idx == 0 || end.After(last) {
+			last = end
+		}
+	}
+
+	callTree.StartTime = first.UnixNano()
+	callTree.Duration = last.Sub(first).Nanoseconds()
+
+	return callTree
+}
+
+// callStart Function that returns when a call started (or the time of its log if the proxy did not tell)
+func callStart(apiLog *protobuf.APILog) time.Time {
+	if apiLog.StartTime > 0 {
+		return time.Unix(0, apiLog.StartTime)
+	}
+	return logTime(apiLog.TimeStamp)
+}
+
+// callDuration Function that returns how long a call took (in nanoseconds) as seen by the caller if possible
+func callDuration(apiLog *protobuf.APILog) int64 {
+	if apiLog.ClientLatency > 0 {
+		return apiLog.ClientLatency
+	}
+	return apiLog.ServerLatency
+}
+
+// callsInto Function that checks if the source of a call is the workload that served another call
+func callsInto(parent, child *protobuf.APILog) bool {
+	if child.SrcType == "" || child.SrcType == "Unknown" || child.SrcName == "" {
+		return false
+	}
+
+	if parent.DstCluster != child.SrcCluster || parent.DstNamespace != child.SrcNamespace {
+		return false
+	}
+
+	return child.SrcName == parent.DstPod || child.SrcName == parent.DstName
+}
+
+// runningAt Function that checks if a call was still running at a given time
+// (calls whose logs only have second timestamps are given a second of slack)
+func runningAt(apiLog *protobuf.APILog, at time.Time) bool {
+	slack := callTreeSlack
+	if apiLog.StartTime == 0 {
+		slack = time.Second
+	}
+
+	start := callStart(apiLog)
+	if at.Before(start.Add(-slack)) {
+		return false
+	}
+
+	duration := callDuration(apiLog)
+	return duration == 0 || !at.After(start.Add(time.Duration(duration)+slack))
+}
+
+// newCallSpanID Function that returns a random span ID
+// (a trace can be split across call trees, so IDs derived from its position would repeat)
+func newCallSpanID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// == //
@@ -24,23 +24,21 @@ type ColService struct {
 // ColH global reference for Collector Handler
 var ColH *ColHandler
 
-type ServiceInfo struct {
-	Cluster   string
-	Namespace string
-	Name      string
-}
-
 // ColHandler Structure
 type ColHandler struct {
 	colService  net.Listener
 	grpcServer  *grpc.Server
 	grpcService *ColService
 
-	ipToService map[string]*ServiceInfo      // key: IP, value: serviceInfo
-	svcCache    map[string]*protobuf.Service // key: "cluster/namespace/name" value: *protobuf.Service
+	state *StateStore // state shared by the gRPC handlers and the log processors
+
+	clusterState *ClusterState
 
-	apiLogChan  chan interface{}
-	metricsChan chan interface{}
+	apiLogChan   chan interface{}
+	metricsChan  chan interface{}
+	connLogChan  chan interface{}
+	auditLogChan chan interface{}
+	noticeChan   chan interface{}
 
 	stopChan chan struct{}
 }
@@ -54,11 +52,16 @@ func init() {
 func NewColHandler() *ColHandler {
 	lh := &ColHandler{
 		grpcService: new(ColService),
-		ipToService: make(map[string]*ServiceInfo),
-		svcCache:    make(map[string]*protobuf.Service),
 
-		apiLogChan:  make(chan interface{}),
-		metricsChan: make(chan interface{}),
+		state: NewStateStore(),
+
+		clusterState: NewClusterState(),
+
+		apiLogChan:   make(chan interface{}),
+		metricsChan:  make(chan interface{}),
+		connLogChan:  make(chan interface{}),
+		auditLogChan: make(chan interface{}),
+		noticeChan:   make(chan interface{}),
 
 		stopChan: make(chan struct{}),
 	}
@@ -83,8 +86,17 @@ func StartCollector(wg *sync.WaitGroup) bool {
 
 	log.Printf("[Collector] Listening Collector gRPC services (%s)", collectorService)
 
-	// Create gRPC Service
-	gRPCServer := grpc.NewServer()
+	// Reload the cluster state kept in the store before agents report again
+	RestoreClusterState()
+
+	// Create gRPC Service (with TLS and agent authentication if configured)
+	opts, err := collectorServerOptions()
+	if err != nil {
+		log.Printf("[Collector] Failed to configure Collector gRPC services: %v", err)
+		return false
+	}
+
+	gRPCServer := grpc.NewServer(opts...)
 	ColH.grpcServer = gRPCServer
 
 	protobuf.RegisterSentryFlowServer(gRPCServer, ColH.grpcService)
@@ -100,6 +112,15 @@ func StartCollector(wg *sync.WaitGroup) bool {
 	// handle Envoy metrics
 	go ProcessEnvoyMetrics(wg)
 
+	// handle connection logs
+	go ProcessConnectionLogs(wg)
+
+	// handle audit logs
+	go ProcessAuditLogs(wg)
+
+	// handle cluster notices
+	go ProcessClusterNotices(wg)
+
 	log.Print("[LogProcessor] Started Log Processors")
 
 	return true
@@ -117,6 +138,15 @@ func StopCollector() bool {
 	// One for ProcessMetrics
 	ColH.stopChan <- struct{}{}
 
+	// One for ProcessConnectionLogs
+	ColH.stopChan <- struct{}{}
+
+	// One for ProcessAuditLogs
+	ColH.stopChan <- struct{}{}
+
+	// One for ProcessClusterNotices
+	ColH.stopChan <- struct{}{}
+
 	log.Print("[LogProcessor] Stopped Log Processors")
 
 	return true
@@ -3,12 +3,16 @@
 package collector
 
 import (
+	"Operator/config"
 	"Operator/exporter"
+	"Operator/graph"
+	"Operator/storage"
 	"context"
 	"fmt"
 	"io"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/Jitria/SentryFlow/protobuf"
 )
@@ -21,6 +25,7 @@ import (
 func (cs *ColService) AddDeployEvent(ctx context.Context, dep *protobuf.Deploy) (*protobuf.Response, error) {
 	log.Printf("[Operator] AddDeployEvent: got Deploy %s/%s cluster=%s", dep.Namespace, dep.Name, dep.Cluster)
 
+	trackClusterObject(dep)
 	exporter.InsertDeployAdd(dep)
 	return &protobuf.Response{Msg: 0}, nil
 }
@@ -28,6 +33,7 @@ func (cs *ColService) AddDeployEvent(ctx context.Context, dep *protobuf.Deploy)
 func (cs *ColService) UpdateDeployEvent(ctx context.Context, dep *protobuf.Deploy) (*protobuf.Response, error) {
 	log.Printf("[Operator] UpdateDeployEvent: got Deploy %s/%s cluster=%s", dep.Namespace, dep.Name, dep.Cluster)
 
+	trackClusterObject(dep)
 	exporter.InsertDeployUpdate(dep)
 	return &protobuf.Response{Msg: 0}, nil
 }
@@ -35,6 +41,7 @@ func (cs *ColService) UpdateDeployEvent(ctx context.Context, dep *protobuf.Deplo
 func (cs *ColService) DeleteDeployEvent(ctx context.Context, dep *protobuf.Deploy) (*protobuf.Response, error) {
 	log.Printf("[Operator] DeleteDeployEvent: got Deploy %s/%s cluster=%s", dep.Namespace, dep.Name, dep.Cluster)
 
+	untrackClusterObject(dep)
 	exporter.InsertDeployDelete(dep)
 	return &protobuf.Response{Msg: 0}, nil
 }
@@ -44,6 +51,9 @@ func (cs *ColService) AddPodEvent(ctx context.Context, pod *protobuf.Pod) (*prot
 	log.Printf("[Operator] AddPodEvent: got Pod %s/%s cluster=%s IP=%s",
 		pod.Namespace, pod.Name, pod.Cluster, pod.PodIP)
 
+	ColH.state.ipIndex.Bind(podOwner(pod), podIPs(pod))
+
+	trackClusterObject(pod)
 	exporter.InsertPodAdd(pod)
 	return &protobuf.Response{Msg: 0}, nil
 }
@@ -52,6 +62,9 @@ func (cs *ColService) UpdatePodEvent(ctx context.Context, pod *protobuf.Pod) (*p
 	log.Printf("[Operator] UpdatePodEvent: got Pod %s/%s cluster=%s IP=%s",
 		pod.Namespace, pod.Name, pod.Cluster, pod.PodIP)
 
+	ColH.state.ipIndex.Bind(podOwner(pod), podIPs(pod))
+
+	trackClusterObject(pod)
 	exporter.InsertPodUpdate(pod)
 	return &protobuf.Response{Msg: 0}, nil
 }
@@ -60,6 +73,9 @@ func (cs *ColService) DeletePodEvent(ctx context.Context, pod *protobuf.Pod) (*p
 	log.Printf("[Operator] DeletePodEvent: got Pod %s/%s cluster=%s",
 		pod.Namespace, pod.Name, pod.Cluster)
 
+	ColH.state.ipIndex.Unbind(podOwner(pod))
+
+	untrackClusterObject(pod)
 	exporter.InsertPodDelete(pod)
 	return &protobuf.Response{Msg: 0}, nil
 }
@@ -69,34 +85,9 @@ func (cs *ColService) AddSvcEvent(ctx context.Context, svc *protobuf.Service) (*
 	log.Printf("[Operator] AddSvcEvent: got Service %s/%s cluster=%s clusterIP=%s",
 		svc.Namespace, svc.Name, svc.Cluster, svc.ClusterIP)
 
-	key := fmt.Sprintf("%s/%s/%s", svc.Cluster, svc.Namespace, svc.Name)
-
-	if oldSvc, found := ColH.svcCache[key]; found {
-		for _, oldIP := range oldSvc.ExternalIPs {
-			delete(ColH.ipToService, oldIP)
-		}
-		for _, oldIP := range oldSvc.LoadBalancerIPs {
-			delete(ColH.ipToService, oldIP)
-		}
-	}
-
-	for _, extIP := range svc.ExternalIPs {
-		ColH.ipToService[extIP] = &ServiceInfo{
-			Cluster:   svc.Cluster,
-			Namespace: svc.Namespace,
-			Name:      svc.Name,
-		}
-	}
-	for _, lbIP := range svc.LoadBalancerIPs {
-		ColH.ipToService[lbIP] = &ServiceInfo{
-			Cluster:   svc.Cluster,
-			Namespace: svc.Namespace,
-			Name:      svc.Name,
-		}
-	}
-
-	ColH.svcCache[key] = svc
+	ColH.state.ipIndex.Bind(serviceOwner(svc), serviceIPs(svc))
 
+	trackClusterObject(svc)
 	exporter.InsertSvcAdd(svc)
 	return &protobuf.Response{Msg: 0}, nil
 }
@@ -105,33 +96,9 @@ func (cs *ColService) UpdateSvcEvent(ctx context.Context, svc *protobuf.Service)
 	log.Printf("[Operator] UpdateSvcEvent: got Service %s/%s cluster=%s clusterIP=%s",
 		svc.Namespace, svc.Name, svc.Cluster, svc.ClusterIP)
 
-	key := fmt.Sprintf("%s/%s/%s", svc.Cluster, svc.Namespace, svc.Name)
-
-	if oldSvc, found := ColH.svcCache[key]; found {
-		for _, oldIP := range oldSvc.ExternalIPs {
-			delete(ColH.ipToService, oldIP)
-		}
-		for _, oldIP := range oldSvc.LoadBalancerIPs {
-			delete(ColH.ipToService, oldIP)
-		}
-	}
-
-	for _, extIP := range svc.ExternalIPs {
-		ColH.ipToService[extIP] = &ServiceInfo{
-			Cluster:   svc.Cluster,
-			Namespace: svc.Namespace,
-			Name:      svc.Name,
-		}
-	}
-	for _, lbIP := range svc.LoadBalancerIPs {
-		ColH.ipToService[lbIP] = &ServiceInfo{
-			Cluster:   svc.Cluster,
-			Namespace: svc.Namespace,
-			Name:      svc.Name,
-		}
-	}
-	ColH.svcCache[key] = svc
+	ColH.state.ipIndex.Bind(serviceOwner(svc), serviceIPs(svc))
 
+	trackClusterObject(svc)
 	exporter.InsertSvcUpdate(svc)
 	return &protobuf.Response{Msg: 0}, nil
 }
@@ -140,35 +107,230 @@ func (cs *ColService) DeleteSvcEvent(ctx context.Context, svc *protobuf.Service)
 	log.Printf("[Operator] DeleteSvcEvent: got Service %s/%s cluster=%s",
 		svc.Namespace, svc.Name, svc.Cluster)
 
-	key := fmt.Sprintf("%s/%s/%s", svc.Cluster, svc.Namespace, svc.Name)
-
-	if oldSvc, found := ColH.svcCache[key]; found {
-		for _, oldIP := range oldSvc.ExternalIPs {
-			delete(ColH.ipToService, oldIP)
-		}
-		for _, oldIP := range oldSvc.LoadBalancerIPs {
-			delete(ColH.ipToService, oldIP)
-		}
-		delete(ColH.svcCache, key)
-	} else {
-		for _, extIP := range svc.ExternalIPs {
-			delete(ColH.ipToService, extIP)
-		}
-		for _, lbIP := range svc.LoadBalancerIPs {
-			delete(ColH.ipToService, lbIP)
-		}
-	}
+	ColH.state.ipIndex.Unbind(serviceOwner(svc))
 
+	untrackClusterObject(svc)
 	exporter.InsertSvcDelete(svc)
 	return &protobuf.Response{Msg: 0}, nil
 }
 
+// StatefulSet Function
+func (cs *ColService) AddStatefulSetEvent(ctx context.Context, sts *protobuf.StatefulSet) (*protobuf.Response, error) {
+	log.Printf("[Operator] AddStatefulSetEvent: got StatefulSet %s/%s cluster=%s", sts.Namespace, sts.Name, sts.Cluster)
+
+	trackClusterObject(sts)
+	exporter.InsertStatefulSetAdd(sts)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) UpdateStatefulSetEvent(ctx context.Context, sts *protobuf.StatefulSet) (*protobuf.Response, error) {
+	log.Printf("[Operator] UpdateStatefulSetEvent: got StatefulSet %s/%s cluster=%s", sts.Namespace, sts.Name, sts.Cluster)
+
+	trackClusterObject(sts)
+	exporter.InsertStatefulSetUpdate(sts)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) DeleteStatefulSetEvent(ctx context.Context, sts *protobuf.StatefulSet) (*protobuf.Response, error) {
+	log.Printf("[Operator] DeleteStatefulSetEvent: got StatefulSet %s/%s cluster=%s", sts.Namespace, sts.Name, sts.Cluster)
+
+	untrackClusterObject(sts)
+	exporter.InsertStatefulSetDelete(sts)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// DaemonSet Function
+func (cs *ColService) AddDaemonSetEvent(ctx context.Context, ds *protobuf.DaemonSet) (*protobuf.Response, error) {
+	log.Printf("[Operator] AddDaemonSetEvent: got DaemonSet %s/%s cluster=%s", ds.Namespace, ds.Name, ds.Cluster)
+
+	trackClusterObject(ds)
+	exporter.InsertDaemonSetAdd(ds)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) UpdateDaemonSetEvent(ctx context.Context, ds *protobuf.DaemonSet) (*protobuf.Response, error) {
+	log.Printf("[Operator] UpdateDaemonSetEvent: got DaemonSet %s/%s cluster=%s", ds.Namespace, ds.Name, ds.Cluster)
+
+	trackClusterObject(ds)
+	exporter.InsertDaemonSetUpdate(ds)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) DeleteDaemonSetEvent(ctx context.Context, ds *protobuf.DaemonSet) (*protobuf.Response, error) {
+	log.Printf("[Operator] DeleteDaemonSetEvent: got DaemonSet %s/%s cluster=%s", ds.Namespace, ds.Name, ds.Cluster)
+
+	untrackClusterObject(ds)
+	exporter.InsertDaemonSetDelete(ds)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// Job Function
+func (cs *ColService) AddJobEvent(ctx context.Context, job *protobuf.Job) (*protobuf.Response, error) {
+	log.Printf("[Operator] AddJobEvent: got Job %s/%s cluster=%s", job.Namespace, job.Name, job.Cluster)
+
+	trackClusterObject(job)
+	exporter.InsertJobAdd(job)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) UpdateJobEvent(ctx context.Context, job *protobuf.Job) (*protobuf.Response, error) {
+	log.Printf("[Operator] UpdateJobEvent: got Job %s/%s cluster=%s", job.Namespace, job.Name, job.Cluster)
+
+	trackClusterObject(job)
+	exporter.InsertJobUpdate(job)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) DeleteJobEvent(ctx context.Context, job *protobuf.Job) (*protobuf.Response, error) {
+	log.Printf("[Operator] DeleteJobEvent: got Job %s/%s cluster=%s", job.Namespace, job.Name, job.Cluster)
+
+	untrackClusterObject(job)
+	exporter.InsertJobDelete(job)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// CronJob Function
+func (cs *ColService) AddCronJobEvent(ctx context.Context, cj *protobuf.CronJob) (*protobuf.Response, error) {
+	log.Printf("[Operator] AddCronJobEvent: got CronJob %s/%s cluster=%s", cj.Namespace, cj.Name, cj.Cluster)
+
+	trackClusterObject(cj)
+	exporter.InsertCronJobAdd(cj)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) UpdateCronJobEvent(ctx context.Context, cj *protobuf.CronJob) (*protobuf.Response, error) {
+	log.Printf("[Operator] UpdateCronJobEvent: got CronJob %s/%s cluster=%s", cj.Namespace, cj.Name, cj.Cluster)
+
+	trackClusterObject(cj)
+	exporter.InsertCronJobUpdate(cj)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) DeleteCronJobEvent(ctx context.Context, cj *protobuf.CronJob) (*protobuf.Response, error) {
+	log.Printf("[Operator] DeleteCronJobEvent: got CronJob %s/%s cluster=%s", cj.Namespace, cj.Name, cj.Cluster)
+
+	untrackClusterObject(cj)
+	exporter.InsertCronJobDelete(cj)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// Node Function
+func (cs *ColService) AddNodeEvent(ctx context.Context, node *protobuf.Node) (*protobuf.Response, error) {
+	log.Printf("[Operator] AddNodeEvent: got Node %s cluster=%s", node.Name, node.Cluster)
+
+	trackClusterObject(node)
+	exporter.InsertNodeAdd(node)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) UpdateNodeEvent(ctx context.Context, node *protobuf.Node) (*protobuf.Response, error) {
+	log.Printf("[Operator] UpdateNodeEvent: got Node %s cluster=%s", node.Name, node.Cluster)
+
+	trackClusterObject(node)
+	exporter.InsertNodeUpdate(node)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) DeleteNodeEvent(ctx context.Context, node *protobuf.Node) (*protobuf.Response, error) {
+	log.Printf("[Operator] DeleteNodeEvent: got Node %s cluster=%s", node.Name, node.Cluster)
+
+	untrackClusterObject(node)
+	exporter.InsertNodeDelete(node)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// Namespace Function
+func (cs *ColService) AddNamespaceEvent(ctx context.Context, ns *protobuf.Namespace) (*protobuf.Response, error) {
+	log.Printf("[Operator] AddNamespaceEvent: got Namespace %s cluster=%s", ns.Name, ns.Cluster)
+
+	trackClusterObject(ns)
+	exporter.InsertNamespaceAdd(ns)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) UpdateNamespaceEvent(ctx context.Context, ns *protobuf.Namespace) (*protobuf.Response, error) {
+	log.Printf("[Operator] UpdateNamespaceEvent: got Namespace %s cluster=%s", ns.Name, ns.Cluster)
+
+	trackClusterObject(ns)
+	exporter.InsertNamespaceUpdate(ns)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) DeleteNamespaceEvent(ctx context.Context, ns *protobuf.Namespace) (*protobuf.Response, error) {
+	log.Printf("[Operator] DeleteNamespaceEvent: got Namespace %s cluster=%s", ns.Name, ns.Cluster)
+
+	untrackClusterObject(ns)
+	exporter.InsertNamespaceDelete(ns)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// Ingress Function
+func (cs *ColService) AddIngressEvent(ctx context.Context, ing *protobuf.Ingress) (*protobuf.Response, error) {
+	log.Printf("[Operator] AddIngressEvent: got Ingress %s/%s cluster=%s", ing.Namespace, ing.Name, ing.Cluster)
+
+	trackClusterObject(ing)
+	exporter.InsertIngressAdd(ing)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) UpdateIngressEvent(ctx context.Context, ing *protobuf.Ingress) (*protobuf.Response, error) {
+	log.Printf("[Operator] UpdateIngressEvent: got Ingress %s/%s cluster=%s", ing.Namespace, ing.Name, ing.Cluster)
+
+	trackClusterObject(ing)
+	exporter.InsertIngressUpdate(ing)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) DeleteIngressEvent(ctx context.Context, ing *protobuf.Ingress) (*protobuf.Response, error) {
+	log.Printf("[Operator] DeleteIngressEvent: got Ingress %s/%s cluster=%s", ing.Namespace, ing.Name, ing.Cluster)
+
+	untrackClusterObject(ing)
+	exporter.InsertIngressDelete(ing)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+// ServiceEndpoints Function
+func (cs *ColService) AddServiceEndpointsEvent(ctx context.Context, se *protobuf.ServiceEndpoints) (*protobuf.Response, error) {
+	log.Printf("[Operator] AddServiceEndpointsEvent: got ServiceEndpoints %s/%s cluster=%s ready=%d notReady=%d",
+		se.Namespace, se.Name, se.Cluster, se.ReadyCount, se.NotReadyCount)
+
+	ColH.state.UpdateServiceEndpoints(se)
+
+	trackClusterObject(se)
+	exporter.InsertServiceEndpointsAdd(se)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) UpdateServiceEndpointsEvent(ctx context.Context, se *protobuf.ServiceEndpoints) (*protobuf.Response, error) {
+	log.Printf("[Operator] UpdateServiceEndpointsEvent: got ServiceEndpoints %s/%s cluster=%s ready=%d notReady=%d",
+		se.Namespace, se.Name, se.Cluster, se.ReadyCount, se.NotReadyCount)
+
+	ColH.state.UpdateServiceEndpoints(se)
+
+	trackClusterObject(se)
+	exporter.InsertServiceEndpointsUpdate(se)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
+func (cs *ColService) DeleteServiceEndpointsEvent(ctx context.Context, se *protobuf.ServiceEndpoints) (*protobuf.Response, error) {
+	log.Printf("[Operator] DeleteServiceEndpointsEvent: got ServiceEndpoints %s/%s cluster=%s",
+		se.Namespace, se.Name, se.Cluster)
+
+	ColH.state.DeleteServiceEndpoints(se)
+
+	untrackClusterObject(se)
+	exporter.InsertServiceEndpointsDelete(se)
+	return &protobuf.Response{Msg: 0}, nil
+}
+
 ////////////
 // APILog //
 ////////////
 
 // GiveAPILog Function
 func (cs *ColService) GiveAPILog(stream protobuf.SentryFlow_GiveAPILogServer) error {
+	reporter := reportingCluster(stream.Context())
+
 	for {
 		// Receive APILog from stream.
 		apiLog, err := stream.Recv()
@@ -178,14 +340,40 @@ func (cs *ColService) GiveAPILog(stream protobuf.SentryFlow_GiveAPILogServer) er
 		if err != nil {
 			return fmt.Errorf("GiveAPILog recv error: %v", err)
 		}
-		ColH.apiLogChan <- apiLog
+		ColH.apiLogChan <- &reportedAPILog{apiLog: apiLog, reporter: reporter}
 	}
 }
 
+// reportedAPILog Structure that keeps the cluster of the agent that reported an API log
+type reportedAPILog struct {
+	apiLog   *protobuf.APILog
+	reporter string
+}
+
 // ProcessAPILogs Function
 func ProcessAPILogs(wg *sync.WaitGroup) {
 	wg.Add(1)
 
+	window := time.Duration(config.GlobalConfig.DedupWindow) * time.Second
+	if window <= 0 {
+		window = 2 * time.Second
+	}
+
+	// Reports waiting for their counterpart are flushed a few times per window
+	dedup := NewAPILogDeduplicator(window)
+	dedupTicker := time.NewTicker(window / 4)
+	defer dedupTicker.Stop()
+
+	timeout := time.Duration(config.GlobalConfig.CallTreeTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	// Call trees are complete once no call of their request arrives for a while
+	correlator := NewCallTreeCorrelator(timeout)
+	callTreeTicker := time.NewTicker(time.Second)
+	defer callTreeTicker.Stop()
+
 	for {
 		select {
 		case logType, ok := <-ColH.apiLogChan:
@@ -194,26 +382,31 @@ func ProcessAPILogs(wg *sync.WaitGroup) {
 				continue
 			}
 
-			apiLog := logType.(*protobuf.APILog)
+			reported := logType.(*reportedAPILog)
+			apiLog := reported.apiLog
+
+			resolveAPILog(apiLog, reported.reporter)
 
-			if apiLog.DstCluster == "Unknown" {
-				if si, found := ColH.ipToService[apiLog.DstIP]; found {
-					apiLog.DstCluster = si.Cluster
-					apiLog.DstNamespace = si.Namespace
-					apiLog.DstName = si.Name
-					apiLog.DstType = "Service"
+			ColH.state.JoinServiceEndpoints(apiLog)
+
+			if config.GlobalConfig.DedupAPILogs {
+				if apiLog = dedup.Add(apiLog, time.Now()); apiLog == nil {
+					continue
 				}
 			}
-			if apiLog.SrcCluster == "Unknown" {
-				if si, found := ColH.ipToService[apiLog.SrcIP]; found {
-					apiLog.SrcCluster = si.Cluster
-					apiLog.SrcNamespace = si.Namespace
-					apiLog.SrcName = si.Name
-					apiLog.SrcType = "Service"
-				}
+
+			dispatchAPILog(apiLog, correlator)
+
+		case <-dedupTicker.C:
+			for _, apiLog := range dedup.Expire(time.Now()) {
+				dispatchAPILog(apiLog, correlator)
+			}
+
+		case <-callTreeTicker.C:
+			for _, callTree := range correlator.Expire(time.Now()) {
+				go exporter.InsertCallTree(callTree)
 			}
 
-			go exporter.InsertAPILog(apiLog)
 		case <-ColH.stopChan:
 			wg.Done()
 			return
@@ -221,6 +414,51 @@ func ProcessAPILogs(wg *sync.WaitGroup) {
 	}
 }
 
+// dispatchAPILog Function that hands a processed API log to the exporter, the store, the service graph and the correlator
+func dispatchAPILog(apiLog *protobuf.APILog, correlator *CallTreeCorrelator) {
+	go exporter.InsertAPILog(apiLog)
+	go storage.InsertAPILog(apiLog)
+
+	graph.RecordAPILog(apiLog)
+
+	if config.GlobalConfig.CorrelateAPILogs {
+		correlator.Add(apiLog, time.Now())
+	}
+}
+
+// resolveAPILog Function that resolves the unknown ends of an API log against the IP index
+func resolveAPILog(apiLog *protobuf.APILog, reporter string) {
+	// Without a known reporter, the cluster of the resolved end is the best guess
+	if reporter == "" {
+		if apiLog.SrcCluster != "Unknown" {
+			reporter = apiLog.SrcCluster
+		} else {
+			reporter = apiLog.DstCluster
+		}
+	}
+
+	at := callStart(apiLog)
+
+	if apiLog.DstCluster == "Unknown" {
+		if owner, found := ColH.state.ipIndex.Lookup(apiLog.DstIP, reporter, at); found {
+			apiLog.DstCluster = owner.Cluster
+			apiLog.DstNamespace = owner.Namespace
+			apiLog.DstName = owner.Name
+			apiLog.DstLabel = owner.Labels
+			apiLog.DstType = owner.Type
+		}
+	}
+	if apiLog.SrcCluster == "Unknown" {
+		if owner, found := ColH.state.ipIndex.Lookup(apiLog.SrcIP, reporter, at); found {
+			apiLog.SrcCluster = owner.Cluster
+			apiLog.SrcNamespace = owner.Namespace
+			apiLog.SrcName = owner.Name
+			apiLog.SrcLabel = owner.Labels
+			apiLog.SrcType = owner.Type
+		}
+	}
+}
+
 /////////////////
 // EnovyMetric //
 /////////////////
@@ -253,6 +491,131 @@ func ProcessEnvoyMetrics(wg *sync.WaitGroup) {
 			}
 
 			go exporter.InsertEnvoyMetrics(logType.(*protobuf.EnvoyMetrics))
+			go storage.InsertEnvoyMetrics(logType.(*protobuf.EnvoyMetrics))
+
+		case <-ColH.stopChan:
+			wg.Done()
+			return
+		}
+	}
+}
+
+///////////////////
+// ConnectionLog //
+///////////////////
+
+// GiveConnectionLog Function
+func (cs *ColService) GiveConnectionLog(stream protobuf.SentryFlow_GiveConnectionLogServer) error {
+	for {
+		// Receive ConnectionLog from stream.
+		connLog, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&protobuf.Response{Msg: 0})
+		}
+		if err != nil {
+			return fmt.Errorf("GiveConnectionLog recv error: %v", err)
+		}
+		ColH.connLogChan <- connLog
+	}
+}
+
+// ProcessConnectionLogs Function
+func ProcessConnectionLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+
+	for {
+		select {
+		case logType, ok := <-ColH.connLogChan:
+			if !ok {
+				log.Print("[LogProcessor] Failed to process a connection log")
+				continue
+			}
+
+			go exporter.InsertConnectionLog(logType.(*protobuf.ConnectionLog))
+
+		case <-ColH.stopChan:
+			wg.Done()
+			return
+		}
+	}
+}
+
+// == //
+
+///////////////////
+// ClusterNotice //
+///////////////////
+
+// GiveClusterNotice Function
+func (cs *ColService) GiveClusterNotice(stream protobuf.SentryFlow_GiveClusterNoticeServer) error {
+	for {
+		// Receive ClusterNotice from stream.
+		notice, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&protobuf.Response{Msg: 0})
+		}
+		if err != nil {
+			return fmt.Errorf("GiveClusterNotice recv error: %v", err)
+		}
+		ColH.noticeChan <- notice
+	}
+}
+
+// ProcessClusterNotices Function
+func ProcessClusterNotices(wg *sync.WaitGroup) {
+	wg.Add(1)
+
+	for {
+		select {
+		case notice, ok := <-ColH.noticeChan:
+			if !ok {
+				log.Print("[LogProcessor] Failed to process a cluster notice")
+				continue
+			}
+
+			go exporter.InsertClusterNotice(notice.(*protobuf.ClusterNotice))
+
+		case <-ColH.stopChan:
+			wg.Done()
+			return
+		}
+	}
+}
+
+// == //
+
+//////////////
+// AuditLog //
+//////////////
+
+// GiveAuditLog Function
+func (cs *ColService) GiveAuditLog(stream protobuf.SentryFlow_GiveAuditLogServer) error {
+	for {
+		// Receive AuditLog from stream.
+		auditLog, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&protobuf.Response{Msg: 0})
+		}
+		if err != nil {
+			return fmt.Errorf("GiveAuditLog recv error: %v", err)
+		}
+		ColH.auditLogChan <- auditLog
+	}
+}
+
+// ProcessAuditLogs Function
+func ProcessAuditLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+
+	for {
+		select {
+		case logType, ok := <-ColH.auditLogChan:
+			if !ok {
+				log.Print("[LogProcessor] Failed to process an audit log")
+				continue
+			}
+
+			go exporter.InsertAuditLog(logType.(*protobuf.AuditLog))
 
 		case <-ColH.stopChan:
 			wg.Done()
@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// ipHistoryRetention is how long an IP address is remembered after its owner releases it
+// (logs are processed a little after they happen, so they may refer to an owner that is gone)
+const ipHistoryRetention = 10 * time.Minute
+
+// ipBindingSlack absorbs the second resolution of log timestamps around binding changes
+const ipBindingSlack = time.Second
+
+// IPOwner Structure that describes the pod or service that holds an IP address
+type IPOwner struct {
+	Cluster   string
+	Namespace string
+	Name      string
+	Type      string // "Pod" or "Service"
+	Labels    map[string]string
+}
+
+// key Function
+func (owner *IPOwner) key() string {
+	return fmt.Sprintf("%s/%s/%s/%s", owner.Type, owner.Cluster, owner.Namespace, owner.Name)
+}
+
+// ipBinding Structure that records the period during which an owner held an IP address
+type ipBinding struct {
+	owner *IPOwner
+	since time.Time
+	until time.Time // zero while the owner still holds the address
+}
+
+// activeAt Function
+func (b *ipBinding) activeAt(t time.Time) bool {
+	return !t.Before(b.since.Add(-ipBindingSlack)) && (b.until.IsZero() || t.Before(b.until.Add(ipBindingSlack)))
+}
+
+// IPIndex Structure that maps IP addresses to their owners across clusters over time
+type IPIndex struct {
+	lock sync.RWMutex
+
+	bindings map[string][]*ipBinding // key: IP
+	ownerIPs map[string][]string     // key: owner key, value: IPs currently held
+}
+
+// NewIPIndex Function
+func NewIPIndex() *IPIndex {
+	return &IPIndex{
+		bindings: make(map[string][]*ipBinding),
+		ownerIPs: make(map[string][]string),
+	}
+}
+
+// == //
+
+// Bind Function that records the IP addresses that an owner holds from now on
+// (addresses it held before and does not hold anymore are released)
+func (idx *IPIndex) Bind(owner *IPOwner, ips []string) {
+	now := time.Now()
+	key := owner.key()
+
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	held := make(map[string]bool)
+	for _, ip := range ips {
+		if ip != "" && ip != "None" {
+			held[ip] = true
+		}
+	}
+
+	for _, ip := range idx.ownerIPs[key] {
+		if !held[ip] {
+			idx.release(ip, key, now)
+		}
+	}
+
+	current := make([]string, 0, len(held))
+	for ip := range held {
+		current = append(current, ip)
+		idx.bind(ip, owner, now)
+	}
+
+	if len(current) == 0 {
+		delete(idx.ownerIPs, key)
+	} else {
+		idx.ownerIPs[key] = current
+	}
+}
+
+// Unbind Function that releases all IP addresses held by an owner
+func (idx *IPIndex) Unbind(owner *IPOwner) {
+	now := time.Now()
+	key := owner.key()
+
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	for _, ip := range idx.ownerIPs[key] {
+		idx.release(ip, key, now)
+	}
+	delete(idx.ownerIPs, key)
+}
+
+// bind Function that opens a binding (or refreshes the owner of an open one)
+func (idx *IPIndex) bind(ip string, owner *IPOwner, now time.Time) {
+	key := owner.key()
+
+	bindings := idx.prune(ip, now)
+	for _, b := range bindings {
+		if b.until.IsZero() && b.owner.key() == key {
+			b.owner = owner
+			return
+		}
+	}
+
+	// The first owner seen in a cluster is assumed to have held the address all along
+	// (e.g., pods that were running before Operator started)
+	since := time.Time{}
+
+	// In a single cluster, a new service takes the address over from the previous one, while pods
+	// share it until each of them is unbound (hostNetwork pods all hold the IP of their node)
+	for _, b := range bindings {
+		if b.owner.Cluster != owner.Cluster {
+			continue
+		}
+		since = now
+		if b.until.IsZero() && b.owner.Type == owner.Type && owner.Type == "Service" {
+			b.until = now
+		}
+	}
+
+	idx.bindings[ip] = append(bindings, &ipBinding{owner: owner, since: since})
+}
+
+// release Function that closes the binding of an owner
+func (idx *IPIndex) release(ip string, key string, now time.Time) {
+	for _, b := range idx.bindings[ip] {
+		if b.until.IsZero() && b.owner.key() == key {
+			b.until = now
+		}
+	}
+	idx.prune(ip, now)
+}
+
+// prune Function that forgets the bindings of an IP address released long ago
+func (idx *IPIndex) prune(ip string, now time.Time) []*ipBinding {
+	bindings := idx.bindings[ip]
+
+	kept := bindings[:0]
+	for _, b := range bindings {
+		if b.until.IsZero() || now.Sub(b.until) < ipHistoryRetention {
+			kept = append(kept, b)
+		}
+	}
+
+	if len(kept) == 0 {
+		delete(idx.bindings, ip)
+		return nil
+	}
+
+	idx.bindings[ip] = kept
+	return kept
+}
+
+// == //
+
+// Lookup Function that returns the owner of an IP address at a given time
+// (pod CIDRs may overlap across clusters, so the owner in the reporting cluster is preferred,
+// and an address held in several other clusters is not resolved)
+func (idx *IPIndex) Lookup(ip string, reportingCluster string, at time.Time) (*IPOwner, bool) {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	candidates := make(map[string]*ipBinding)
+	for _, b := range idx.bindings[ip] {
+		if !b.activeAt(at) {
+			continue
+		}
+
+		// The latest owner in each cluster wins (among the pods that share an address, the latest one still running)
+		if prev, ok := candidates[b.owner.Cluster]; !ok || b.since.After(prev.since) {
+			candidates[b.owner.Cluster] = b
+		}
+	}
+
+	if b, ok := candidates[reportingCluster]; ok {
+		return b.owner, true
+	}
+
+	if len(candidates) == 1 {
+		for _, b := range candidates {
+			return b.owner, true
+		}
+	}
+
+	return nil, false
+}
+
+// == //
+
+// podOwner Function
+func podOwner(pod *protobuf.Pod) *IPOwner {
+	return &IPOwner{
+		Cluster:   pod.Cluster,
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		Type:      "Pod",
+		Labels:    pod.Labels,
+	}
+}
+
+// podIPs Function that returns the IP addresses that a pod holds
+// (finished pods give their address back to the cluster)
+func podIPs(pod *protobuf.Pod) []string {
+	if pod.Status == "Succeeded" || pod.Status == "Failed" {
+		return nil
+	}
+	return []string{pod.PodIP}
+}
+
+// serviceOwner Function
+func serviceOwner(svc *protobuf.Service) *IPOwner {
+	return &IPOwner{
+		Cluster:   svc.Cluster,
+		Namespace: svc.Namespace,
+		Name:      svc.Name,
+		Type:      "Service",
+		Labels:    svc.Labels,
+	}
+}
+
+// serviceIPs Function that returns the ClusterIP, external IPs and load balancer IPs of a service
+func serviceIPs(svc *protobuf.Service) []string {
+	ips := []string{svc.ClusterIP}
+	ips = append(ips, svc.ExternalIPs...)
+	ips = append(ips, svc.LoadBalancerIPs...)
+	return ips
+}
+
+// parseTimeStamp Function that parses a timestamp in Unix seconds or in RFC 3339
+// (Envoy writes the start time of OTel access logs in RFC 3339 between brackets)
+func parseTimeStamp(timeStamp string) (time.Time, bool) {
+	timeStamp = strings.Trim(timeStamp, "[]")
+
+	if sec, err := strconv.ParseInt(timeStamp, 10, 64); err == nil && sec > 0 {
+		return time.Unix(sec, 0), true
+	}
+	if at, err := time.Parse(time.RFC3339Nano, timeStamp); err == nil {
+		return at, true
+	}
+	return time.Time{}, false
+}
+
+// logTime Function that returns the time of a log (or now if it has no valid timestamp)
+func logTime(timeStamp string) time.Time {
+	if at, ok := parseTimeStamp(timeStamp); ok {
+		return at
+	}
+	return time.Now()
+}
+
+// == //
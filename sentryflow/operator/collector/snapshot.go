@@ -0,0 +1,436 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"Operator/storage"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+// ClusterState Structure that keeps the last known objects of each cluster
+type ClusterState struct {
+	objects    map[string]map[string]map[string]proto.Message // key: cluster, resourceType, object key
+	lastEvents map[string]map[string]map[string]time.Time     // when an event last changed an object (deletes included)
+	lock       sync.Mutex
+}
+
+// NewClusterState Function
+func NewClusterState() *ClusterState {
+	return &ClusterState{
+		objects:    make(map[string]map[string]map[string]proto.Message),
+		lastEvents: make(map[string]map[string]map[string]time.Time),
+	}
+}
+
+// noteClusterEvent Function that records when an event changed an object (called with the lock held)
+func (cst *ClusterState) noteClusterEvent(cluster, resourceType, key string) {
+	if _, ok := cst.lastEvents[cluster]; !ok {
+		cst.lastEvents[cluster] = make(map[string]map[string]time.Time)
+	}
+	if _, ok := cst.lastEvents[cluster][resourceType]; !ok {
+		cst.lastEvents[cluster][resourceType] = make(map[string]time.Time)
+	}
+	cst.lastEvents[cluster][resourceType][key] = time.Now()
+}
+
+// clusterObjectKey Function that returns the cluster, resource type and key of an object
+func clusterObjectKey(obj proto.Message) (string, string, string) {
+	switch o := obj.(type) {
+	case *protobuf.Pod:
+		return o.Cluster, "Pod", fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *protobuf.Service:
+		return o.Cluster, "Service", fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *protobuf.Deploy:
+		return o.Cluster, "Deploy", fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *protobuf.StatefulSet:
+		return o.Cluster, "StatefulSet", fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *protobuf.DaemonSet:
+		return o.Cluster, "DaemonSet", fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *protobuf.Job:
+		return o.Cluster, "Job", fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *protobuf.CronJob:
+		return o.Cluster, "CronJob", fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *protobuf.Node:
+		return o.Cluster, "Node", o.Name
+	case *protobuf.Namespace:
+		return o.Cluster, "Namespace", o.Name
+	case *protobuf.Ingress:
+		return o.Cluster, "Ingress", fmt.Sprintf("%s/%s/%s", o.Kind, o.Namespace, o.Name)
+	case *protobuf.ServiceEndpoints:
+		return o.Cluster, "ServiceEndpoints", fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	}
+	return "", "", ""
+}
+
+// trackClusterObject Function that records an added or updated object
+func trackClusterObject(obj proto.Message) {
+	cluster, resourceType, key := clusterObjectKey(obj)
+	if resourceType == "" {
+		return
+	}
+
+	storage.PutClusterObject(resourceType, fmt.Sprintf("%s/%s", cluster, key), obj)
+
+	rememberClusterObject(obj)
+
+	ColH.clusterState.lock.Lock()
+	ColH.clusterState.noteClusterEvent(cluster, resourceType, key)
+	ColH.clusterState.lock.Unlock()
+}
+
+// rememberClusterObject Function that keeps an object in the cluster state
+func rememberClusterObject(obj proto.Message) {
+	cluster, resourceType, key := clusterObjectKey(obj)
+
+	ColH.clusterState.lock.Lock()
+	defer ColH.clusterState.lock.Unlock()
+
+	if _, ok := ColH.clusterState.objects[cluster]; !ok {
+		ColH.clusterState.objects[cluster] = make(map[string]map[string]proto.Message)
+	}
+	if _, ok := ColH.clusterState.objects[cluster][resourceType]; !ok {
+		ColH.clusterState.objects[cluster][resourceType] = make(map[string]proto.Message)
+	}
+	ColH.clusterState.objects[cluster][resourceType][key] = obj
+}
+
+// untrackClusterObject Function that forgets a deleted object
+func untrackClusterObject(obj proto.Message) {
+	cluster, resourceType, key := clusterObjectKey(obj)
+	if resourceType == "" {
+		return
+	}
+
+	storage.DeleteClusterObject(resourceType, fmt.Sprintf("%s/%s", cluster, key))
+
+	ColH.clusterState.lock.Lock()
+	defer ColH.clusterState.lock.Unlock()
+
+	if objects, ok := ColH.clusterState.objects[cluster][resourceType]; ok {
+		delete(objects, key)
+	}
+	ColH.clusterState.noteClusterEvent(cluster, resourceType, key)
+}
+
+// RestoreClusterState Function that reloads the objects kept in the store
+// (the IP index and the endpoints are rebuilt too, so that logs resolve before agents report again)
+func RestoreClusterState() {
+	restored := 0
+
+	for _, resourceType := range storage.ClusterObjectTypes() {
+		for _, obj := range storage.LoadClusterObjects(resourceType) {
+			rememberClusterObject(obj)
+
+			switch o := obj.(type) {
+			case *protobuf.Pod:
+				ColH.state.ipIndex.Bind(podOwner(o), podIPs(o))
+			case *protobuf.Service:
+				ColH.state.ipIndex.Bind(serviceOwner(o), serviceIPs(o))
+			case *protobuf.ServiceEndpoints:
+				ColH.state.UpdateServiceEndpoints(o)
+			}
+
+			restored++
+		}
+	}
+
+	if restored > 0 {
+		log.Printf("[Collector] Restored %d cluster objects from the store", restored)
+	}
+}
+
+// == //
+
+// snapshotObjects Function that flattens a snapshot into a list of objects
+func snapshotObjects(snap *protobuf.ClusterSnapshot) []proto.Message {
+	var objects []proto.Message
+
+	for _, obj := range snap.Pods {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.Services {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.Deploys {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.StatefulSets {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.DaemonSets {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.Jobs {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.CronJobs {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.Nodes {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.Namespaces {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.Ingresses {
+		objects = append(objects, obj)
+	}
+	for _, obj := range snap.ServiceEndpoints {
+		objects = append(objects, obj)
+	}
+
+	return objects
+}
+
+// checkSnapshotClusters Function that checks that every object of a snapshot belongs to its cluster
+// (agents are authorized by the cluster of the snapshot, so its objects must not name another one)
+func checkSnapshotClusters(snap *protobuf.ClusterSnapshot) error {
+	for _, obj := range snapshotObjects(snap) {
+		if cluster, resourceType, key := clusterObjectKey(obj); cluster != snap.Cluster {
+			return fmt.Errorf("%s %s belongs to cluster %q, not %q", resourceType, key, cluster, snap.Cluster)
+		}
+	}
+	return nil
+}
+
+// SyncClusterSnapshot Function that receives the chunks of a full snapshot of a cluster and reconciles
+// the state of the cluster against it once every chunk arrived
+// (Agent builds a snapshot when it sends it, after the events before it, so it is as new as the arrival of its
+// first chunk; that time is compared with the events on the clock of Operator, not with the timestamp of Agent)
+func (cs *ColService) SyncClusterSnapshot(stream protobuf.SentryFlow_SyncClusterSnapshotServer) error {
+	var snap *protobuf.ClusterSnapshot
+	var receivedAt time.Time
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("SyncClusterSnapshot recv error: %v", err)
+		}
+
+		if snap == nil {
+			receivedAt = time.Now()
+			snap = &protobuf.ClusterSnapshot{Cluster: chunk.Cluster, TimeStamp: chunk.TimeStamp}
+			log.Printf("[Operator] SyncClusterSnapshot: got snapshot cluster=%s timeStamp=%s", snap.Cluster, snap.TimeStamp)
+		} else if chunk.Cluster != snap.Cluster || chunk.TimeStamp != snap.TimeStamp {
+			log.Printf("[Operator] SyncClusterSnapshot: rejected snapshot cluster=%s: chunk of cluster=%s timeStamp=%s",
+				snap.Cluster, chunk.Cluster, chunk.TimeStamp)
+			return status.Error(codes.InvalidArgument, "every chunk of a snapshot must have the same cluster and timeStamp")
+		}
+
+		if err := checkSnapshotClusters(chunk); err != nil {
+			log.Printf("[Operator] SyncClusterSnapshot: rejected snapshot cluster=%s: %v", snap.Cluster, err)
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		proto.Merge(snap, chunk)
+	}
+
+	if snap != nil {
+		cs.reconcileClusterSnapshot(stream.Context(), snap, receivedAt)
+	}
+
+	return stream.SendAndClose(&protobuf.Response{Msg: 0})
+}
+
+// reconcileClusterSnapshot Function that reconciles the state of a cluster against its full snapshot
+// (objects changed by an event since the snapshot started arriving are left to that event, so that
+// the snapshot does not bring back a deleted object or remove a new one)
+func (cs *ColService) reconcileClusterSnapshot(ctx context.Context, snap *protobuf.ClusterSnapshot, receivedAt time.Time) {
+	var added, updated, deleted []proto.Message
+
+	seen := make(map[string]map[string]bool)
+	skipped := 0
+
+	ColH.clusterState.lock.Lock()
+
+	known := ColH.clusterState.objects[snap.Cluster]
+	lastEvents := ColH.clusterState.lastEvents[snap.Cluster]
+
+	changedSince := func(resourceType, key string) bool {
+		at, ok := lastEvents[resourceType][key]
+		return ok && !at.Before(receivedAt)
+	}
+
+	for _, obj := range snapshotObjects(snap) {
+		_, resourceType, key := clusterObjectKey(obj)
+
+		if _, ok := seen[resourceType]; !ok {
+			seen[resourceType] = make(map[string]bool)
+		}
+		seen[resourceType][key] = true
+
+		if changedSince(resourceType, key) {
+			skipped++
+			continue
+		}
+
+		old, found := known[resourceType][key]
+		if !found {
+			added = append(added, obj)
+		} else if !proto.Equal(old, obj) {
+			updated = append(updated, obj)
+		}
+	}
+
+	// Objects that vanished from the cluster while events were missed
+	for resourceType, objects := range known {
+		for key, obj := range objects {
+			if seen[resourceType][key] {
+				continue
+			}
+			if changedSince(resourceType, key) {
+				skipped++
+				continue
+			}
+			deleted = append(deleted, obj)
+		}
+	}
+
+	// Events from before the snapshot are covered by it
+	for _, events := range lastEvents {
+		for key, at := range events {
+			if at.Before(receivedAt) {
+				delete(events, key)
+			}
+		}
+	}
+
+	ColH.clusterState.lock.Unlock()
+
+	for _, obj := range added {
+		cs.applyClusterObject(ctx, "ADD", obj)
+	}
+	for _, obj := range updated {
+		cs.applyClusterObject(ctx, "UPDATE", obj)
+	}
+	for _, obj := range deleted {
+		cs.applyClusterObject(ctx, "DELETE", obj)
+	}
+
+	log.Printf("[Operator] SyncClusterSnapshot: reconciled cluster=%s (added=%d, updated=%d, deleted=%d, changed since=%d)",
+		snap.Cluster, len(added), len(updated), len(deleted), skipped)
+}
+
+// applyClusterObject Function that replays a reconciled object through the regular event handlers
+func (cs *ColService) applyClusterObject(ctx context.Context, action string, obj proto.Message) {
+	switch o := obj.(type) {
+	case *protobuf.Pod:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddPodEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdatePodEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeletePodEvent(ctx, o)
+		}
+	case *protobuf.Service:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddSvcEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateSvcEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteSvcEvent(ctx, o)
+		}
+	case *protobuf.Deploy:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddDeployEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateDeployEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteDeployEvent(ctx, o)
+		}
+	case *protobuf.StatefulSet:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddStatefulSetEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateStatefulSetEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteStatefulSetEvent(ctx, o)
+		}
+	case *protobuf.DaemonSet:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddDaemonSetEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateDaemonSetEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteDaemonSetEvent(ctx, o)
+		}
+	case *protobuf.Job:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddJobEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateJobEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteJobEvent(ctx, o)
+		}
+	case *protobuf.CronJob:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddCronJobEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateCronJobEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteCronJobEvent(ctx, o)
+		}
+	case *protobuf.Node:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddNodeEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateNodeEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteNodeEvent(ctx, o)
+		}
+	case *protobuf.Namespace:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddNamespaceEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateNamespaceEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteNamespaceEvent(ctx, o)
+		}
+	case *protobuf.Ingress:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddIngressEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateIngressEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteIngressEvent(ctx, o)
+		}
+	case *protobuf.ServiceEndpoints:
+		switch action {
+		case "ADD":
+			_, _ = cs.AddServiceEndpointsEvent(ctx, o)
+		case "UPDATE":
+			_, _ = cs.UpdateServiceEndpointsEvent(ctx, o)
+		case "DELETE":
+			_, _ = cs.DeleteServiceEndpointsEvent(ctx, o)
+		}
+	}
+}
+
+// == //
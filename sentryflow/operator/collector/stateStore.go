@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// EndpointInfo Structure
+type EndpointInfo struct {
+	Cluster   string
+	Namespace string
+	Service   string
+	Pod       string
+	Ready     bool
+}
+
+// endpointKey Function that returns the key of an endpoint IP
+func endpointKey(cluster, ip string) string {
+	return fmt.Sprintf("%s/%s", cluster, ip)
+}
+
+// StateStore Structure that keeps the cluster state written by the gRPC handlers
+// and read by the log processors
+// (gRPC handlers run concurrently, so every access goes through the methods below:
+// the endpoint maps are guarded by lock, and the IP index guards itself)
+type StateStore struct {
+	ipIndex *IPIndex // pod and service IPs of every cluster
+
+	lock         sync.RWMutex
+	svcEndpoints map[string]*protobuf.ServiceEndpoints // key: "cluster/namespace/name"
+	ipToEndpoint map[string][]*EndpointInfo            // key: "cluster/IP" (a pod may back several services)
+}
+
+// NewStateStore Function
+func NewStateStore() *StateStore {
+	return &StateStore{
+		ipIndex: NewIPIndex(),
+
+		svcEndpoints: make(map[string]*protobuf.ServiceEndpoints),
+		ipToEndpoint: make(map[string][]*EndpointInfo),
+	}
+}
+
+// == //
+
+// UpdateServiceEndpoints Function that refreshes the endpoint IP index of a service
+// (and marks the service when none of its endpoints is ready, before it is stored and exported)
+func (st *StateStore) UpdateServiceEndpoints(se *protobuf.ServiceEndpoints) {
+	key := fmt.Sprintf("%s/%s/%s", se.Cluster, se.Namespace, se.Name)
+
+	se.NoReadyEndpoints = se.ReadyCount == 0
+
+	st.lock.Lock()
+
+	if oldSE, found := st.svcEndpoints[key]; found {
+		st.removeEndpointIPs(oldSE)
+	}
+
+	for _, ep := range se.Endpoints {
+		ipKey := endpointKey(se.Cluster, ep.Ip)
+		st.ipToEndpoint[ipKey] = append(st.ipToEndpoint[ipKey], &EndpointInfo{
+			Cluster:   se.Cluster,
+			Namespace: se.Namespace,
+			Service:   se.Name,
+			Pod:       ep.PodName,
+			Ready:     ep.Ready,
+		})
+	}
+	st.svcEndpoints[key] = se
+
+	st.lock.Unlock()
+
+	if se.NoReadyEndpoints {
+		log.Printf("[Operator] Service %s/%s (cluster=%s) has no ready endpoints", se.Namespace, se.Name, se.Cluster)
+	}
+}
+
+// DeleteServiceEndpoints Function
+func (st *StateStore) DeleteServiceEndpoints(se *protobuf.ServiceEndpoints) {
+	key := fmt.Sprintf("%s/%s/%s", se.Cluster, se.Namespace, se.Name)
+
+	st.lock.Lock()
+	defer st.lock.Unlock()
+
+	if oldSE, found := st.svcEndpoints[key]; found {
+		st.removeEndpointIPs(oldSE)
+		delete(st.svcEndpoints, key)
+	}
+}
+
+// removeEndpointIPs Function (the caller holds lock)
+func (st *StateStore) removeEndpointIPs(se *protobuf.ServiceEndpoints) {
+	for _, ep := range se.Endpoints {
+		ipKey := endpointKey(se.Cluster, ep.Ip)
+
+		infos := st.ipToEndpoint[ipKey][:0]
+		for _, ei := range st.ipToEndpoint[ipKey] {
+			if ei.Namespace != se.Namespace || ei.Service != se.Name {
+				infos = append(infos, ei)
+			}
+		}
+
+		if len(infos) == 0 {
+			delete(st.ipToEndpoint, ipKey)
+		} else {
+			st.ipToEndpoint[ipKey] = infos
+		}
+	}
+}
+
+// JoinServiceEndpoints Function that ties the destination of an API log to its service and serving pod
+func (st *StateStore) JoinServiceEndpoints(apiLog *protobuf.APILog) {
+	st.lock.RLock()
+	defer st.lock.RUnlock()
+
+	switch apiLog.DstType {
+	case "Pod":
+		// a pod reached directly (e.g., upstream of a sidecar) is tied back to the service it backs
+		// (when it backs several services, the log cannot tell which one was called)
+		infos := st.ipToEndpoint[endpointKey(apiLog.DstCluster, apiLog.DstIP)]
+		if len(infos) == 0 {
+			return
+		}
+		apiLog.DstPod = infos[0].Pod
+		if len(infos) == 1 {
+			apiLog.DstService = infos[0].Service
+		}
+	case "Service":
+		// a ClusterIP can only be tied to a pod when exactly one endpoint is ready
+		apiLog.DstService = apiLog.DstName
+
+		key := fmt.Sprintf("%s/%s/%s", apiLog.DstCluster, apiLog.DstNamespace, apiLog.DstName)
+		se, found := st.svcEndpoints[key]
+		if !found || se.ReadyCount != 1 {
+			return
+		}
+		for _, ep := range se.Endpoints {
+			if ep.Ready {
+				apiLog.DstPod = ep.PodName
+				return
+			}
+		}
+	}
+}
+
+// == //
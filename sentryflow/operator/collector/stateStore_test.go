@@ -0,0 +1,443 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"Operator/config"
+	"Operator/exporter"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// TestMain Function that runs the exporter and the log processors behind the collector under test
+func TestMain(m *testing.M) {
+	log.SetOutput(io.Discard)
+
+	config.GlobalConfig.ExporterAddr = "127.0.0.1"
+	config.GlobalConfig.ExporterPort = "0"
+
+	wg := &sync.WaitGroup{}
+	if !exporter.StartExporter(wg) {
+		fmt.Println("failed to start the exporter")
+		os.Exit(1)
+	}
+
+	go ProcessAPILogs(wg)
+
+	os.Exit(m.Run())
+}
+
+// newTestClient Function that serves the collector over an in-process connection
+func newTestClient(t *testing.T) protobuf.SentryFlowClient {
+	t.Helper()
+
+	opts, err := collectorServerOptions()
+	if err != nil {
+		t.Fatalf("collectorServerOptions: %v", err)
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(opts...)
+	protobuf.RegisterSentryFlowServer(server, ColH.grpcService)
+	go func() { _ = server.Serve(listener) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient: %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	return protobuf.NewSentryFlowClient(conn)
+}
+
+// syncTestSnapshot Function that sends a snapshot in one chunk per kind of object
+func syncTestSnapshot(ctx context.Context, client protobuf.SentryFlowClient, snap *protobuf.ClusterSnapshot) error {
+	stream, err := client.SyncClusterSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	chunks := []*protobuf.ClusterSnapshot{
+		{Cluster: snap.Cluster, TimeStamp: snap.TimeStamp, Pods: snap.Pods},
+		{Cluster: snap.Cluster, TimeStamp: snap.TimeStamp, Services: snap.Services},
+		{Cluster: snap.Cluster, TimeStamp: snap.TimeStamp, ServiceEndpoints: snap.ServiceEndpoints},
+	}
+	for _, chunk := range chunks {
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// testPod Function
+func testPod(cluster string, idx int) *protobuf.Pod {
+	return &protobuf.Pod{
+		Cluster:   cluster,
+		Namespace: "default",
+		Name:      fmt.Sprintf("pod-%d", idx),
+		PodIP:     fmt.Sprintf("10.0.0.%d", idx),
+		Status:    "Running",
+		Labels:    map[string]string{"app": fmt.Sprintf("app-%d", idx)},
+	}
+}
+
+// testService Function
+func testService(cluster string, idx int) *protobuf.Service {
+	return &protobuf.Service{
+		Cluster:   cluster,
+		Namespace: "default",
+		Name:      fmt.Sprintf("svc-%d", idx),
+		Type:      "ClusterIP",
+		ClusterIP: fmt.Sprintf("10.96.0.%d", idx),
+	}
+}
+
+// testEndpoints Function
+func testEndpoints(cluster string, idx int) *protobuf.ServiceEndpoints {
+	return &protobuf.ServiceEndpoints{
+		Cluster:    cluster,
+		Namespace:  "default",
+		Name:       fmt.Sprintf("svc-%d", idx),
+		ReadyCount: 1,
+		Endpoints: []*protobuf.EndpointRef{
+			{Ip: fmt.Sprintf("10.0.0.%d", idx), PodNamespace: "default", PodName: fmt.Sprintf("pod-%d", idx), Ready: true},
+		},
+	}
+}
+
+// testAPILog Function
+func testAPILog(idx int) *protobuf.APILog {
+	return &protobuf.APILog{
+		TimeStamp:  strconv.FormatInt(time.Now().Unix(), 10),
+		SrcCluster: "Unknown",
+		SrcIP:      fmt.Sprintf("10.0.0.%d", idx),
+		DstCluster: "Unknown",
+		DstIP:      fmt.Sprintf("10.96.0.%d", idx),
+		Method:     "GET",
+		Path:       "/",
+	}
+}
+
+// == //
+
+// TestConcurrentIngestion Function that drives cluster events, snapshots, heartbeats and API logs
+// concurrently through the collector (run with -race)
+func TestConcurrentIngestion(t *testing.T) {
+	client := newTestClient(t)
+
+	const clusters = 3
+	const objects = 20
+	const rounds = 5
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 1024)
+
+	for c := 0; c < clusters; c++ {
+		cluster := fmt.Sprintf("cluster-%d", c)
+		agentCtx := metadata.AppendToOutgoingContext(context.Background(), agentIDMetadataKey, "agent-"+cluster)
+
+		// Cluster events
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := 0; r < rounds; r++ {
+				for i := 1; i <= objects; i++ {
+					calls := []func() error{
+						func() error { _, err := client.AddPodEvent(agentCtx, testPod(cluster, i)); return err },
+						func() error { _, err := client.AddSvcEvent(agentCtx, testService(cluster, i)); return err },
+						func() error {
+							_, err := client.UpdateServiceEndpointsEvent(agentCtx, testEndpoints(cluster, i))
+							return err
+						},
+						func() error { _, err := client.UpdatePodEvent(agentCtx, testPod(cluster, i)); return err },
+					}
+					if r%2 == 1 {
+						calls = append(calls,
+							func() error { _, err := client.DeleteSvcEvent(agentCtx, testService(cluster, i)); return err },
+							func() error {
+								_, err := client.DeleteServiceEndpointsEvent(agentCtx, testEndpoints(cluster, i))
+								return err
+							},
+							func() error { _, err := client.AddSvcEvent(agentCtx, testService(cluster, i)); return err },
+							func() error {
+								_, err := client.AddServiceEndpointsEvent(agentCtx, testEndpoints(cluster, i))
+								return err
+							},
+						)
+					}
+					for _, call := range calls {
+						if err := call(); err != nil {
+							errs <- err
+							return
+						}
+					}
+				}
+			}
+		}()
+
+		// Snapshots
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := 0; r < rounds; r++ {
+				snap := &protobuf.ClusterSnapshot{Cluster: cluster, TimeStamp: strconv.FormatInt(time.Now().Unix(), 10)}
+				for i := 1; i <= objects; i++ {
+					snap.Pods = append(snap.Pods, testPod(cluster, i))
+					snap.Services = append(snap.Services, testService(cluster, i))
+					snap.ServiceEndpoints = append(snap.ServiceEndpoints, testEndpoints(cluster, i))
+				}
+				if err := syncTestSnapshot(agentCtx, client, snap); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+
+		// Registration and heartbeats
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			info := &protobuf.AgentInfo{AgentID: "agent-" + cluster, Cluster: cluster, Version: "test"}
+			if _, err := client.RegisterAgent(agentCtx, info); err != nil {
+				errs <- err
+				return
+			}
+			for r := 0; r < rounds; r++ {
+				if _, err := client.Heartbeat(agentCtx, &protobuf.AgentHeartbeat{AgentID: info.AgentID, Cluster: cluster}); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := (&exporter.ExpService{}).ListAgents(context.Background(), &protobuf.ClientInfo{}); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+
+		// API logs
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := 0; r < rounds; r++ {
+				stream, err := client.GiveAPILog(agentCtx)
+				if err != nil {
+					errs <- err
+					return
+				}
+				for i := 1; i <= objects; i++ {
+					if err := stream.Send(testAPILog(i)); err != nil {
+						errs <- err
+						return
+					}
+				}
+				if _, err := stream.CloseAndRecv(); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("ingestion failed: %v", err)
+	}
+
+	// Every cluster ends with all of its pods, services and endpoints in place
+	for c := 0; c < clusters; c++ {
+		cluster := fmt.Sprintf("cluster-%d", c)
+
+		for i := 1; i <= objects; i++ {
+			apiLog := testAPILog(i)
+			resolveAPILog(apiLog, cluster)
+			ColH.state.JoinServiceEndpoints(apiLog)
+
+			if apiLog.SrcCluster != cluster || apiLog.SrcName != fmt.Sprintf("pod-%d", i) || apiLog.SrcType != "Pod" {
+				t.Errorf("source of %s not resolved in %s: %s/%s (%s)", apiLog.SrcIP, cluster, apiLog.SrcCluster, apiLog.SrcName, apiLog.SrcType)
+			}
+			if apiLog.DstCluster != cluster || apiLog.DstName != fmt.Sprintf("svc-%d", i) || apiLog.DstType != "Service" {
+				t.Errorf("destination of %s not resolved in %s: %s/%s (%s)", apiLog.DstIP, cluster, apiLog.DstCluster, apiLog.DstName, apiLog.DstType)
+			}
+			if apiLog.DstPod != fmt.Sprintf("pod-%d", i) {
+				t.Errorf("destination of %s in %s not joined to its pod: %q", apiLog.DstIP, cluster, apiLog.DstPod)
+			}
+		}
+	}
+}
+
+// TestSnapshotRejectsForeignObjects Function that sends a snapshot of one cluster carrying objects of another
+func TestSnapshotRejectsForeignObjects(t *testing.T) {
+	client := newTestClient(t)
+
+	victim := testPod("victim", 7)
+	if _, err := client.AddPodEvent(context.Background(), victim); err != nil {
+		t.Fatalf("AddPodEvent: %v", err)
+	}
+
+	spoofed := testPod("victim", 7)
+	spoofed.PodIP = "10.0.9.9"
+
+	snap := &protobuf.ClusterSnapshot{
+		Cluster: "attacker",
+		Pods:    []*protobuf.Pod{testPod("attacker", 1), spoofed},
+	}
+	if err := syncTestSnapshot(context.Background(), client, snap); err == nil {
+		t.Fatal("snapshot with objects of another cluster should be rejected")
+	}
+
+	ColH.clusterState.lock.Lock()
+	stored := ColH.clusterState.objects["victim"]["Pod"]["default/pod-7"]
+	_, applied := ColH.clusterState.objects["attacker"]["Pod"]["default/pod-1"]
+	ColH.clusterState.lock.Unlock()
+
+	if pod, ok := stored.(*protobuf.Pod); !ok || pod.PodIP != victim.PodIP {
+		t.Errorf("pod of the other cluster should be untouched, got %v", stored)
+	}
+	if applied {
+		t.Error("no object of a rejected snapshot should be applied")
+	}
+	if owner, ok := ColH.state.ipIndex.Lookup("10.0.9.9", "victim", time.Now()); ok {
+		t.Errorf("spoofed IP should not be indexed, got %+v", owner)
+	}
+}
+
+// TestSnapshotKeepsNewerEvents Function that reconciles a snapshot that started arriving before a delete and an add
+func TestSnapshotKeepsNewerEvents(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	if _, err := client.AddPodEvent(ctx, testPod("stale", 1)); err != nil {
+		t.Fatalf("AddPodEvent: %v", err)
+	}
+
+	receivedAt := time.Now()
+
+	if _, err := client.DeletePodEvent(ctx, testPod("stale", 1)); err != nil {
+		t.Fatalf("DeletePodEvent: %v", err)
+	}
+	if _, err := client.AddPodEvent(ctx, testPod("stale", 2)); err != nil {
+		t.Fatalf("AddPodEvent: %v", err)
+	}
+
+	snap := &protobuf.ClusterSnapshot{
+		Cluster: "stale",
+		Pods:    []*protobuf.Pod{testPod("stale", 1)},
+	}
+	ColH.grpcService.reconcileClusterSnapshot(ctx, snap, receivedAt)
+
+	ColH.clusterState.lock.Lock()
+	_, deleted := ColH.clusterState.objects["stale"]["Pod"]["default/pod-1"]
+	_, added := ColH.clusterState.objects["stale"]["Pod"]["default/pod-2"]
+	ColH.clusterState.lock.Unlock()
+
+	if deleted {
+		t.Error("a pod deleted after the snapshot started arriving should stay deleted")
+	}
+	if !added {
+		t.Error("a pod added after the snapshot started arriving should be kept")
+	}
+}
+
+// == //
+
+// TestIPIndexPrefersReportingCluster Function
+func TestIPIndexPrefersReportingCluster(t *testing.T) {
+	idx := NewIPIndex()
+
+	idx.Bind(podOwner(testPod("east", 1)), []string{"10.0.0.1"})
+	idx.Bind(podOwner(testPod("west", 1)), []string{"10.0.0.1"})
+	idx.Bind(podOwner(testPod("west", 2)), []string{"10.0.0.2"})
+
+	now := time.Now()
+
+	if owner, ok := idx.Lookup("10.0.0.1", "east", now); !ok || owner.Cluster != "east" {
+		t.Errorf("overlapping IP should resolve in the reporting cluster, got %+v", owner)
+	}
+	if owner, ok := idx.Lookup("10.0.0.1", "west", now); !ok || owner.Cluster != "west" {
+		t.Errorf("overlapping IP should resolve in the reporting cluster, got %+v", owner)
+	}
+	if owner, ok := idx.Lookup("10.0.0.1", "north", now); ok {
+		t.Errorf("overlapping IP should not resolve from another cluster, got %+v", owner)
+	}
+	if owner, ok := idx.Lookup("10.0.0.2", "east", now); !ok || owner.Cluster != "west" {
+		t.Errorf("IP held in a single cluster should resolve there, got %+v", owner)
+	}
+}
+
+// TestIPIndexHistory Function
+func TestIPIndexHistory(t *testing.T) {
+	idx := NewIPIndex()
+
+	first := podOwner(testPod("east", 1))
+	idx.Bind(first, []string{"10.0.0.1"})
+	idx.Unbind(first)
+
+	// A log that arrives after its pod is gone still resolves to it
+	if owner, ok := idx.Lookup("10.0.0.1", "east", time.Now()); !ok || owner.Name != first.Name {
+		t.Errorf("released IP should still resolve to its last owner, got %+v", owner)
+	}
+
+	// Once the address is reused, the new owner wins
+	second := podOwner(&protobuf.Pod{Cluster: "east", Namespace: "default", Name: "pod-new", PodIP: "10.0.0.1", Status: "Running"})
+	idx.Bind(second, []string{"10.0.0.1"})
+
+	if owner, ok := idx.Lookup("10.0.0.1", "east", time.Now()); !ok || owner.Name != "pod-new" {
+		t.Errorf("reused IP should resolve to its new owner, got %+v", owner)
+	}
+
+	// Finished pods give their address back
+	idx.Bind(second, podIPs(&protobuf.Pod{PodIP: "10.0.0.1", Status: "Succeeded"}))
+	if len(idx.ownerIPs[second.key()]) != 0 {
+		t.Errorf("finished pod should not hold an IP, got %v", idx.ownerIPs[second.key()])
+	}
+}
+
+// TestIPIndexSharedPodIP Function that binds hostNetwork pods sharing the IP of their node
+func TestIPIndexSharedPodIP(t *testing.T) {
+	idx := NewIPIndex()
+
+	first := podOwner(testPod("east", 1))
+	second := podOwner(testPod("east", 2))
+	idx.Bind(first, []string{"192.168.0.1"})
+	idx.Bind(second, []string{"192.168.0.1"})
+
+	// Deleting one of the pods leaves the address to the others
+	idx.Unbind(second)
+
+	later := time.Now().Add(2 * ipBindingSlack)
+	if owner, ok := idx.Lookup("192.168.0.1", "east", later); !ok || owner.Name != first.Name {
+		t.Errorf("shared IP should resolve to the pod still holding it, got %+v", owner)
+	}
+}
+
+// == //
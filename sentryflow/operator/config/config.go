@@ -19,6 +19,29 @@ type OperatorConfig struct {
 	ExporterAddr string // IP address to use for exporter gRPC
 	ExporterPort string // Port to use for exporter gRPC
 
+	AgentAuth        bool   // Enable/Disable authenticating agents and enforcing their cluster names
+	AgentCredentials string // Path to the JSON file that maps agent credentials to cluster names
+	CollectorTLSCert string // TLS certificate for Collector gRPC (empty: plaintext)
+	CollectorTLSKey  string // TLS key for Collector gRPC
+	CollectorTLSCA   string // CA for verifying agent client certificates (empty: no client certificates)
+
+	AgentStaleTimeout int // Duration (in seconds) without heartbeats after which an agent is stale
+
+	DedupAPILogs bool // Enable/Disable merging the client-side and server-side reports of a request
+	DedupWindow  int  // Duration (in seconds) to wait for the report of the other end of a request
+
+	CorrelateAPILogs bool   // Enable/Disable stitching API logs into call trees
+	CallTreeTimeout  int    // Duration (in seconds) without new API logs after which a call tree is complete
+	ZipkinURL        string // Zipkin (or Jaeger) endpoint to post call trees to (empty: not posted)
+
+	StorePath      string // Path to the embedded store (empty: nothing is persisted)
+	StoreRetention int    // Duration (in hours) to keep API logs and metrics in the store
+	StoreMaxSize   int    // Size (in MB) of API logs and metrics above which the oldest ones are dropped
+	StorePartition int    // Duration (in minutes) of the time partitions of API logs and metrics
+
+	GraphRetention    int // Duration (in minutes) of traffic that the service graph keeps
+	GraphUpdatePeriod int // Period (in seconds) for sending service graph changes to watchers
+
 	PatchingNamespaces           bool // Enable/Disable patching namespaces with 'istio-injection'
 	RestartingPatchedDeployments bool // Enable/Disable restarting deployments after patching
 
@@ -33,6 +56,7 @@ var GlobalConfig OperatorConfig
 
 // init Function
 func init() {
+	registerCmdLineParams()
 	_ = LoadConfig()
 }
 
@@ -44,46 +68,93 @@ const (
 	ExporterAddr string = "exporterAddr"
 	ExporterPort string = "exporterPort"
 
+	AgentAuth        string = "agentAuth"
+	AgentCredentials string = "agentCredentials"
+	CollectorTLSCert string = "collectorTLSCert"
+	CollectorTLSKey  string = "collectorTLSKey"
+	CollectorTLSCA   string = "collectorTLSCA"
+
+	AgentStaleTimeout string = "agentStaleTimeout"
+
+	DedupAPILogs string = "dedupAPILogs"
+	DedupWindow  string = "dedupWindow"
+
+	CorrelateAPILogs string = "correlateAPILogs"
+	CallTreeTimeout  string = "callTreeTimeout"
+	ZipkinURL        string = "zipkinURL"
+
+	StorePath      string = "storePath"
+	StoreRetention string = "storeRetention"
+	StoreMaxSize   string = "storeMaxSize"
+	StorePartition string = "storePartition"
+
+	GraphRetention    string = "graphRetention"
+	GraphUpdatePeriod string = "graphUpdatePeriod"
+
 	AggregationPeriod string = "aggregationPeriod"
 	CleanUpPeriod     string = "cleanUpPeriod"
 
 	Debug string = "debug"
 )
 
-func readCmdLineParams() {
-	collectorAddrStr := flag.String(CollectorAddr, "0.0.0.0", "Address for Collector gRPC")
-	collectorPortStr := flag.String(CollectorPort, "5317", "Port for Collector gRPC")
+// registerCmdLineParams Function that defines the command line flags (parsed by ParseFlags)
+func registerCmdLineParams() {
+	flag.String(CollectorAddr, "0.0.0.0", "Address for Collector gRPC")
+	flag.String(CollectorPort, "5317", "Port for Collector gRPC")
+
+	flag.String(ExporterAddr, "0.0.0.0", "Address for Exporter gRPC")
+	flag.String(ExporterPort, "8080", "Port for Exporter gRPC")
+
+	flag.Bool(AgentAuth, false, "Enable authenticating agents and enforcing the cluster names they may report")
+	flag.String(AgentCredentials, "/etc/sentryflow/agents.json", "Path to the JSON file that maps agent credentials to cluster names")
+	flag.String(CollectorTLSCert, "", "TLS certificate for Collector gRPC (empty: plaintext)")
+	flag.String(CollectorTLSKey, "", "TLS key for Collector gRPC")
+	flag.String(CollectorTLSCA, "", "CA for verifying agent client certificates (empty: no client certificates)")
+
+	flag.Int(AgentStaleTimeout, 60, "Duration (in seconds) without heartbeats after which an agent is stale")
+
+	flag.Bool(DedupAPILogs, true, "Enable merging the client-side and server-side reports of a request into one API log")
+	flag.Int(DedupWindow, 2, "Duration (in seconds) to wait for the report of the other end of a request")
 
-	exporterAddrStr := flag.String(ExporterAddr, "0.0.0.0", "Address for Exporter gRPC")
-	exporterPortStr := flag.String(ExporterPort, "8080", "Port for Exporter gRPC")
+	flag.Bool(CorrelateAPILogs, true, "Enable stitching API logs that share a trace or request ID into call trees")
+	flag.Int(CallTreeTimeout, 10, "Duration (in seconds) without new API logs after which a call tree is complete")
+	flag.String(ZipkinURL, "", "Zipkin (or Jaeger) endpoint to post call trees to, e.g., http://zipkin:9411/api/v2/spans (empty: not posted)")
 
-	aggregationPeriodInt := flag.Int(AggregationPeriod, 1, "Period for aggregating metrics")
-	cleanUpPeriodInt := flag.Int(CleanUpPeriod, 5, "Period for cleanning up outdated metrics")
+	flag.String(StorePath, "/var/lib/sentryflow/operator.db", "Path to the embedded store (empty: nothing is persisted)")
+	flag.Int(StoreRetention, 24, "Duration (in hours) to keep API logs and metrics in the store")
+	flag.Int(StoreMaxSize, 1024, "Size (in MB) of API logs and metrics above which the oldest ones are dropped")
+	flag.Int(StorePartition, 60, "Duration (in minutes) of the time partitions of API logs and metrics")
 
-	configDebugB := flag.Bool(Debug, false, "Enable debugging mode")
+	flag.Int(GraphRetention, 60, "Duration (in minutes) of traffic that the service graph keeps")
+	flag.Int(GraphUpdatePeriod, 5, "Period (in seconds) for sending service graph changes to watchers")
 
+	flag.Int(AggregationPeriod, 1, "Period for aggregating metrics")
+	flag.Int(CleanUpPeriod, 5, "Period for cleanning up outdated metrics")
+
+	flag.Bool(Debug, false, "Enable debugging mode")
+}
+
+// readCmdLineParams Function that takes the command line flags as defaults
+func readCmdLineParams() {
 	var flags []string
 	flag.VisitAll(func(f *flag.Flag) {
 		kv := fmt.Sprintf("%s:%v", f.Name, f.Value)
 		flags = append(flags, kv)
+
+		if getter, ok := f.Value.(flag.Getter); ok {
+			viper.SetDefault(f.Name, getter.Get())
+		}
 	})
 	log.Printf("Arguments [%s]", strings.Join(flags, " "))
+}
 
+// ParseFlags Function that parses the command line and loads the configuration again
+func ParseFlags() error {
 	flag.Parse()
-
-	viper.SetDefault(CollectorAddr, *collectorAddrStr)
-	viper.SetDefault(CollectorPort, *collectorPortStr)
-
-	viper.SetDefault(ExporterAddr, *exporterAddrStr)
-	viper.SetDefault(ExporterPort, *exporterPortStr)
-
-	viper.SetDefault(AggregationPeriod, *aggregationPeriodInt)
-	viper.SetDefault(CleanUpPeriod, *cleanUpPeriodInt)
-
-	viper.SetDefault(Debug, *configDebugB)
+	return LoadConfig()
 }
 
-// LoadConfig Load configuration
+// LoadConfig Load configuration (from the defaults of the command line flags until ParseFlags)
 func LoadConfig() error {
 	// Read configuration from command line
 	readCmdLineParams()
@@ -97,6 +168,29 @@ func LoadConfig() error {
 	GlobalConfig.ExporterAddr = viper.GetString(ExporterAddr)
 	GlobalConfig.ExporterPort = viper.GetString(ExporterPort)
 
+	GlobalConfig.AgentAuth = viper.GetBool(AgentAuth)
+	GlobalConfig.AgentCredentials = viper.GetString(AgentCredentials)
+	GlobalConfig.CollectorTLSCert = viper.GetString(CollectorTLSCert)
+	GlobalConfig.CollectorTLSKey = viper.GetString(CollectorTLSKey)
+	GlobalConfig.CollectorTLSCA = viper.GetString(CollectorTLSCA)
+
+	GlobalConfig.AgentStaleTimeout = viper.GetInt(AgentStaleTimeout)
+
+	GlobalConfig.DedupAPILogs = viper.GetBool(DedupAPILogs)
+	GlobalConfig.DedupWindow = viper.GetInt(DedupWindow)
+
+	GlobalConfig.CorrelateAPILogs = viper.GetBool(CorrelateAPILogs)
+	GlobalConfig.CallTreeTimeout = viper.GetInt(CallTreeTimeout)
+	GlobalConfig.ZipkinURL = viper.GetString(ZipkinURL)
+
+	GlobalConfig.StorePath = viper.GetString(StorePath)
+	GlobalConfig.StoreRetention = viper.GetInt(StoreRetention)
+	GlobalConfig.StoreMaxSize = viper.GetInt(StoreMaxSize)
+	GlobalConfig.StorePartition = viper.GetInt(StorePartition)
+
+	GlobalConfig.GraphRetention = viper.GetInt(GraphRetention)
+	GlobalConfig.GraphUpdatePeriod = viper.GetInt(GraphUpdatePeriod)
+
 	GlobalConfig.AggregationPeriod = viper.GetInt(AggregationPeriod)
 	GlobalConfig.CleanUpPeriod = viper.GetInt(CleanUpPeriod)
 
@@ -11,6 +11,8 @@ import (
 
 	"Operator/collector"
 	"Operator/exporter"
+	"Operator/graph"
+	"Operator/storage"
 )
 
 // == //
@@ -53,6 +55,20 @@ func (sfo *OperatorService) DestroyOperator() {
 		log.Print("[Operator] Failed to stop Exporters")
 	}
 
+	// Stop service graph
+	if graph.StopGraph() {
+		log.Print("[Operator] Stopped Service Graph")
+	} else {
+		log.Print("[Operator] Failed to stop Service Graph")
+	}
+
+	// Stop store
+	if storage.StopStore() {
+		log.Print("[Operator] Stopped Store")
+	} else {
+		log.Print("[Operator] Failed to stop Store")
+	}
+
 	log.Print("[Operator] Waiting for routine terminations")
 
 	sfo.waitGroup.Wait()
@@ -86,6 +102,19 @@ func Operator() {
 
 	// == //
 
+	// Start store (before collector, which restores its state from it)
+	if !storage.StartStore(sfo.waitGroup) {
+		log.Print("[Operator] Failed to start Store")
+		return
+	}
+
+	// Start service graph (after store, which holds the API logs it is rebuilt from)
+	if !graph.StartGraph(sfo.waitGroup) {
+		log.Print("[Operator] Failed to start Service Graph")
+		_ = storage.StopStore()
+		return
+	}
+
 	// Start collector
 	if !collector.StartCollector(sfo.waitGroup) {
 		sfo.DestroyOperator()
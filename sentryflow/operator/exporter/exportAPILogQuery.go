@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+	"strings"
+	"time"
+
+	"Operator/storage"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// == //
+
+// Page sizes of QueryAPILogs
+const (
+	defaultAPILogPageSize = 100
+	maxAPILogPageSize     = 1000
+)
+
+// maxScannedAPILogs bounds the stored API logs that one page looks at
+// (a page of a narrow query may end early, and its token continues the scan)
+const maxScannedAPILogs = 100000
+
+// QueryAPILogs Function (for gRPC)
+// (since and until select logs by when Operator stored them, which is the order of the store, not by their timestamps)
+func (exs *ExpService) QueryAPILogs(ctx context.Context, query *protobuf.APILogQuery) (*protobuf.APILogPage, error) {
+	if query.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "pageSize is negative")
+	}
+
+	pageSize := int(query.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultAPILogPageSize
+	} else if pageSize > maxAPILogPageSize {
+		pageSize = maxAPILogPageSize
+	}
+
+	since := time.Unix(query.Since, 0)
+	until := time.Now()
+	if query.Until > 0 {
+		// Until is inclusive, so the whole last second is covered
+		until = time.Unix(query.Until, 0).Add(time.Second - 1)
+	}
+	if since.After(until) {
+		return nil, status.Error(codes.InvalidArgument, "since is later than until")
+	}
+
+	var after []byte
+	if query.PageToken != "" {
+		token, err := base64.RawURLEncoding.DecodeString(query.PageToken)
+		if err != nil || len(token) != storage.RecordKeySize {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		after = token
+	}
+
+	page := &protobuf.APILogPage{ApiLogs: make([]*protobuf.APILog, 0, pageSize)}
+
+	var last []byte
+	scanned := 0
+	stopped := false
+
+	err := storage.ScanAPILogs(since, until, query.Descending, after, func(key []byte, apiLog *protobuf.APILog) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+
+		scanned++
+		last = append(last[:0], key...)
+
+		if matchAPILog(query, apiLog) {
+			page.ApiLogs = append(page.ApiLogs, apiLog)
+		}
+
+		if len(page.ApiLogs) >= pageSize || scanned >= maxScannedAPILogs {
+			stopped = true
+			return false
+		}
+		return true
+	})
+	if err == storage.ErrStoreDisabled {
+		return nil, status.Error(codes.FailedPrecondition, "Operator does not store API logs (no store path)")
+	} else if err != nil {
+		log.Printf("[Exporter] Failed to query API logs: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	if stopped {
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString(last)
+	}
+
+	return page, nil
+}
+
+// matchAPILog Function that checks if an API log meets the filters of a query
+// (empty filters match everything)
+func matchAPILog(query *protobuf.APILogQuery, apiLog *protobuf.APILog) bool {
+	filters := []struct {
+		want string
+		got  string
+	}{
+		{query.SrcCluster, apiLog.SrcCluster},
+		{query.SrcNamespace, apiLog.SrcNamespace},
+		{query.SrcName, apiLog.SrcName},
+		{query.SrcType, apiLog.SrcType},
+		{query.DstCluster, apiLog.DstCluster},
+		{query.DstNamespace, apiLog.DstNamespace},
+		{query.DstName, apiLog.DstName},
+		{query.DstType, apiLog.DstType},
+	}
+
+	for _, filter := range filters {
+		if filter.want != "" && filter.want != filter.got {
+			return false
+		}
+	}
+
+	if query.Method != "" && !strings.EqualFold(query.Method, apiLog.Method) {
+		return false
+	}
+	if query.PathPrefix != "" && !strings.HasPrefix(apiLog.Path, query.PathPrefix) {
+		return false
+	}
+
+	if len(query.ResponseCodes) == 0 {
+		return true
+	}
+
+	for _, codeRange := range query.ResponseCodes {
+		highest := codeRange.Max
+		if highest == 0 {
+			highest = codeRange.Min
+		}
+		if apiLog.ResponseCode >= codeRange.Min && apiLog.ResponseCode <= highest {
+			return true
+		}
+	}
+
+	return false
+}
+
+// == //
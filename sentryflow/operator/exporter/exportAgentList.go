@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"Operator/config"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+// ErrAgentOwned is returned when an agent ID registered by one credential is claimed by another
+var ErrAgentOwned = errors.New("the agent ID is registered with another credential")
+
+// defaultAgentStaleTimeout is used when no positive stale timeout is configured
+const defaultAgentStaleTimeout = 60 * time.Second
+
+// agentStaleTimeout Function that returns the duration without heartbeats after which an agent is stale
+func agentStaleTimeout() time.Duration {
+	if config.GlobalConfig.AgentStaleTimeout <= 0 {
+		return defaultAgentStaleTimeout
+	}
+	return time.Duration(config.GlobalConfig.AgentStaleTimeout) * time.Second
+}
+
+// agentEntry Structure that keeps the state of a registered agent
+type agentEntry struct {
+	info  *protobuf.AgentInfo
+	owner string // credential that registered the agent (empty without agent authentication)
+
+	registeredAt  time.Time
+	lastHeartbeat time.Time
+	lastData      time.Time
+	leader        bool
+	stale         bool
+}
+
+// RegisterAgent Function that adds an agent to the registry (or refreshes it when it registers again)
+// (only the credential that registered an agent may register it again)
+func RegisterAgent(info *protobuf.AgentInfo, owner string) error {
+	now := time.Now()
+
+	ExpH.agentLock.Lock()
+	defer ExpH.agentLock.Unlock()
+
+	if entry, ok := ExpH.agents[info.AgentID]; ok {
+		if entry.owner != owner {
+			return fmt.Errorf("%w (agent=%s)", ErrAgentOwned, info.AgentID)
+		}
+
+		log.Printf("[Exporter] Agent %s re-registered (cluster=%s, version=%s)", info.AgentID, info.Cluster, info.Version)
+		entry.info = info
+		entry.lastHeartbeat = now
+		entry.stale = false
+		return nil
+	}
+
+	log.Printf("[Exporter] Agent %s registered (cluster=%s, version=%s, collectors=%v)", info.AgentID, info.Cluster, info.Version, info.Collectors)
+
+	ExpH.agents[info.AgentID] = &agentEntry{
+		info:          info,
+		owner:         owner,
+		registeredAt:  now,
+		lastHeartbeat: now,
+	}
+
+	return nil
+}
+
+// RecordAgentHeartbeat Function that returns false if the agent is not registered
+func RecordAgentHeartbeat(heartbeat *protobuf.AgentHeartbeat, owner string) (bool, error) {
+	ExpH.agentLock.Lock()
+	defer ExpH.agentLock.Unlock()
+
+	entry, ok := ExpH.agents[heartbeat.AgentID]
+	if !ok {
+		return false, nil
+	}
+	if entry.owner != owner {
+		return false, fmt.Errorf("%w (agent=%s)", ErrAgentOwned, heartbeat.AgentID)
+	}
+	if heartbeat.Cluster != entry.info.Cluster {
+		return false, fmt.Errorf("agent %s is registered for cluster %q, not %q", heartbeat.AgentID, entry.info.Cluster, heartbeat.Cluster)
+	}
+
+	if entry.info.ConfigHash != heartbeat.ConfigHash {
+		log.Printf("[Exporter] Agent %s is running with a new configuration (%s -> %s)", heartbeat.AgentID, entry.info.ConfigHash, heartbeat.ConfigHash)
+		entry.info.ConfigHash = heartbeat.ConfigHash
+	}
+
+	if entry.stale {
+		log.Printf("[Exporter] Agent %s (cluster=%s) is back", heartbeat.AgentID, entry.info.Cluster)
+		entry.stale = false
+	}
+
+	entry.lastHeartbeat = time.Now()
+	entry.leader = heartbeat.Leader
+
+	return true, nil
+}
+
+// RecordAgentData Function that records the time an agent last sent telemetry or cluster information
+func RecordAgentData(agentID string) {
+	ExpH.agentLock.Lock()
+	defer ExpH.agentLock.Unlock()
+
+	if entry, ok := ExpH.agents[agentID]; ok {
+		entry.lastData = time.Now()
+	}
+}
+
+// AgentOwnedBy Function that checks if an agent is registered with a given credential
+func AgentOwnedBy(agentID string, owner string) bool {
+	ExpH.agentLock.Lock()
+	defer ExpH.agentLock.Unlock()
+
+	entry, ok := ExpH.agents[agentID]
+	return ok && entry.owner == owner
+}
+
+// AgentCluster Function that returns the cluster of a registered agent
+// (an agent that watches several clusters has no single cluster)
+func AgentCluster(agentID string) (string, bool) {
+	ExpH.agentLock.Lock()
+	defer ExpH.agentLock.Unlock()
+
+	if entry, ok := ExpH.agents[agentID]; ok && len(entry.info.Clusters) <= 1 {
+		return entry.info.Cluster, true
+	}
+	return "", false
+}
+
+// watchAgents Function that marks the agents that stopped sending heartbeats as stale
+func (exp *ExpHandler) watchAgents(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	staleTimeout := agentStaleTimeout()
+
+	ticker := time.NewTicker(staleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+
+			exp.agentLock.Lock()
+			for agentID, entry := range exp.agents {
+				if !entry.stale && now.Sub(entry.lastHeartbeat) > staleTimeout {
+					log.Printf("[Exporter] Agent %s (cluster=%s) is stale, last heartbeat at %s", agentID, entry.info.Cluster, entry.lastHeartbeat.Format(time.RFC3339))
+					entry.stale = true
+				}
+			}
+			exp.agentLock.Unlock()
+
+		case <-exp.stopChan:
+			return
+		}
+	}
+}
+
+// formatAgentTime Function
+func formatAgentTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// == //
+
+// ListAgents Function (for gRPC)
+func (exs *ExpService) ListAgents(ctx context.Context, info *protobuf.ClientInfo) (*protobuf.AgentList, error) {
+	log.Printf("[Exporter] Client %s (%s) connected (ListAgents)", info.HostName, info.IPAddress)
+
+	staleTimeout := agentStaleTimeout()
+	now := time.Now()
+
+	ExpH.agentLock.Lock()
+	defer ExpH.agentLock.Unlock()
+
+	agentList := &protobuf.AgentList{
+		Agents: make([]*protobuf.AgentStatus, 0, len(ExpH.agents)),
+	}
+
+	for _, entry := range ExpH.agents {
+		agentList.Agents = append(agentList.Agents, &protobuf.AgentStatus{
+			Info:          proto.Clone(entry.info).(*protobuf.AgentInfo),
+			RegisteredAt:  formatAgentTime(entry.registeredAt),
+			LastHeartbeat: formatAgentTime(entry.lastHeartbeat),
+			LastData:      formatAgentTime(entry.lastData),
+			Stale:         now.Sub(entry.lastHeartbeat) > staleTimeout,
+			Leader:        entry.leader,
+		})
+	}
+
+	sort.Slice(agentList.Agents, func(i, j int) bool {
+		a, b := agentList.Agents[i].Info, agentList.Agents[j].Info
+		if a.Cluster != b.Cluster {
+			return a.Cluster < b.Cluster
+		}
+		return a.AgentID < b.AgentID
+	})
+
+	return agentList, nil
+}
+
+// == //
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// auditLogStreamInform structure
+type auditLogStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_GetAuditLogServer
+}
+
+// InsertAuditLog Function
+func InsertAuditLog(auditLog *protobuf.AuditLog) {
+	ExpH.exporterAuditLogs <- auditLog
+}
+
+// exportAuditLogs Function
+func (exp *ExpHandler) exportAuditLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	for {
+		select {
+		case auditLog, ok := <-exp.exporterAuditLogs:
+			if !ok {
+				log.Printf("[Exporter] AuditLogs channel closed unexpectedly")
+				return
+			}
+			if err := exp.SendAuditLogs(auditLog); err != nil {
+				log.Printf("[Exporter] Failed to export audit logs: %v", err)
+			}
+
+		case <-exp.stopChan:
+			return
+		}
+	}
+}
+
+// SendAuditLogs Function
+func (exp *ExpHandler) SendAuditLogs(auditLog *protobuf.AuditLog) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.auditLogExporters)
+	newList := make([]*auditLogStreamInform, 0, total)
+
+	for _, exporter := range exp.auditLogExporters {
+		if err := exporter.stream.Send(auditLog); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to export an audit log to %s (%s): %v",
+				exporter.Hostname, exporter.IPAddress, err)
+		} else {
+			newList = append(newList, exporter)
+		}
+	}
+
+	exp.auditLogExporters = newList
+
+	if failed != 0 {
+		msg := fmt.Sprintf("[Exporter] Failed to export audit logs properly (%d/%d failed)", failed, total)
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// GetAuditLog Function (for gRPC)
+func (exs *ExpService) GetAuditLog(info *protobuf.ClientInfo, stream protobuf.SentryFlow_GetAuditLogServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected (GetAuditLog)", info.HostName, info.IPAddress)
+
+	currExporter := &auditLogStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.auditLogExporters = append(ExpH.auditLogExporters, currExporter)
+	ExpH.exporterLock.Unlock()
+
+	select {}
+}
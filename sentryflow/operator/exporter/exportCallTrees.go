@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"Operator/config"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// zipkinQueueSize is the number of call trees that may wait to be posted to Zipkin
+const zipkinQueueSize = 1024
+
+// zipkinClient is shared by every post, so that connections to Zipkin are reused
+var zipkinClient = &http.Client{Timeout: 5 * time.Second}
+
+// callTreeStreamInform structure
+type callTreeStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_GetCallTreeServer
+}
+
+// InsertCallTree Function
+func InsertCallTree(callTree *protobuf.CallTree) {
+	zipkinJSON, err := json.Marshal(zipkinSpans(callTree))
+	if err != nil {
+		log.Printf("[Exporter] Failed to encode the call tree of trace %s: %v", callTree.TraceID, err)
+	} else {
+		callTree.ZipkinJSON = string(zipkinJSON)
+	}
+
+	ExpH.exporterCallTrees <- callTree
+}
+
+// exportCallTrees Function
+func (exp *ExpHandler) exportCallTrees(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	for {
+		select {
+		case callTree, ok := <-exp.exporterCallTrees:
+			if !ok {
+				log.Printf("[Exporter] CallTrees channel closed unexpectedly")
+				return
+			}
+			if err := exp.SendCallTrees(callTree); err != nil {
+				log.Printf("[Exporter] Failed to export call trees: %v", err)
+			}
+			if config.GlobalConfig.ZipkinURL != "" && callTree.ZipkinJSON != "" {
+				// a slow Zipkin must not hold back the clients of call trees
+				select {
+				case exp.zipkinQueue <- callTree:
+				default:
+					log.Printf("[Exporter] Dropped the call tree of trace %s, too many are waiting for %s",
+						callTree.TraceID, config.GlobalConfig.ZipkinURL)
+				}
+			}
+
+		case <-exp.stopChan:
+			return
+		}
+	}
+}
+
+// postZipkinSpans Function that posts the queued call trees to Zipkin one by one
+func (exp *ExpHandler) postZipkinSpans(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	for {
+		select {
+		case callTree := <-exp.zipkinQueue:
+			if err := postCallTree(callTree); err != nil {
+				log.Printf("[Exporter] Failed to post a call tree to %s: %v", config.GlobalConfig.ZipkinURL, err)
+			}
+
+		case <-exp.stopChan:
+			return
+		}
+	}
+}
+
+// SendCallTrees Function
+func (exp *ExpHandler) SendCallTrees(callTree *protobuf.CallTree) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.callTreeExporters)
+	newList := make([]*callTreeStreamInform, 0, total)
+
+	for _, exporter := range exp.callTreeExporters {
+		if err := exporter.stream.Send(callTree); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to export a call tree to %s (%s): %v",
+				exporter.Hostname, exporter.IPAddress, err)
+		} else {
+			newList = append(newList, exporter)
+		}
+	}
+
+	exp.callTreeExporters = newList
+
+	if failed != 0 {
+		msg := fmt.Sprintf("[Exporter] Failed to export call trees properly (%d/%d failed)", failed, total)
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// GetCallTree Function (for gRPC)
+func (exs *ExpService) GetCallTree(info *protobuf.ClientInfo, stream protobuf.SentryFlow_GetCallTreeServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected (GetCallTree)", info.HostName, info.IPAddress)
+
+	currExporter := &callTreeStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.callTreeExporters = append(ExpH.callTreeExporters, currExporter)
+	ExpH.exporterLock.Unlock()
+
+	select {}
+}
+
+// == //
+
+// zipkinEndpoint Structure (Zipkin v2)
+type zipkinEndpoint struct {
+	ServiceName string `json:"serviceName,omitempty"`
+	IPv4        string `json:"ipv4,omitempty"`
+	IPv6        string `json:"ipv6,omitempty"`
+	Port        int    `json:"port,omitempty"`
+}
+
+// newZipkinEndpoint Function that puts the IP of an endpoint in the field of its family
+func newZipkinEndpoint(serviceName string, addr string) *zipkinEndpoint {
+	endpoint := &zipkinEndpoint{ServiceName: serviceName}
+
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return endpoint
+	}
+
+	if ip.To4() != nil {
+		endpoint.IPv4 = ip.String()
+	} else {
+		endpoint.IPv6 = ip.String()
+	}
+
+	return endpoint
+}
+
+// zipkinSpan Structure (Zipkin v2)
+type zipkinSpan struct {
+	TraceID        string            `json:"traceId"`
+	ID             string            `json:"id"`
+	ParentID       string            `json:"parentId,omitempty"`
+	Name           string            `json:"name"`
+	Kind           string            `json:"kind"`
+	Timestamp      int64             `json:"timestamp,omitempty"` // microseconds
+	Duration       int64             `json:"duration,omitempty"`  // microseconds
+	LocalEndpoint  *zipkinEndpoint   `json:"localEndpoint,omitempty"`
+	RemoteEndpoint *zipkinEndpoint   `json:"remoteEndpoint,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+}
+
+// zipkinServiceName Function that names a workload as "name.namespace"
+func zipkinServiceName(namespace string, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", name, namespace)
+}
+
+// zipkinSpans Function that converts a call tree into Zipkin v2 spans
+// (every call is a client span of its caller, with the callee as the remote endpoint)
+func zipkinSpans(callTree *protobuf.CallTree) []*zipkinSpan {
+	spans := make([]*zipkinSpan, 0, len(callTree.Spans))
+
+	for _, callSpan := range callTree.Spans {
+		apiLog := callSpan.ApiLog
+
+		dstName := apiLog.DstName
+		if apiLog.DstService != "" {
+			dstName = apiLog.DstService
+		}
+
+		span := &zipkinSpan{
+			TraceID:   callTree.TraceID,
+			ID:        callSpan.SpanID,
+			ParentID:  callSpan.ParentSpanID,
+			Name:      fmt.Sprintf("%s %s", apiLog.Method, apiLog.Path),
+			Kind:      "CLIENT",
+			Timestamp: callSpan.StartTime / int64(time.Microsecond),
+			Duration:  callSpan.Duration / int64(time.Microsecond),
+
+			LocalEndpoint:  newZipkinEndpoint(zipkinServiceName(apiLog.SrcNamespace, apiLog.SrcName), apiLog.SrcIP),
+			RemoteEndpoint: newZipkinEndpoint(zipkinServiceName(apiLog.DstNamespace, dstName), apiLog.DstIP),
+
+			Tags: map[string]string{
+				"http.method":      apiLog.Method,
+				"http.path":        apiLog.Path,
+				"http.status_code": strconv.Itoa(int(apiLog.ResponseCode)),
+				"cluster":          apiLog.SrcCluster,
+				"peer.cluster":     apiLog.DstCluster,
+			},
+		}
+
+		if port, err := strconv.Atoi(apiLog.DstPort); err == nil {
+			span.RemoteEndpoint.Port = port
+		}
+		if apiLog.DstPod != "" {
+			span.Tags["peer.pod"] = apiLog.DstPod
+		}
+		if apiLog.RequestID != "" {
+			span.Tags["guid:x-request-id"] = apiLog.RequestID
+		}
+		if apiLog.ResponseCode >= 500 {
+			span.Tags["error"] = strconv.Itoa(int(apiLog.ResponseCode))
+		}
+
+		// Zipkin rejects spans with a zero duration
+		if span.Duration == 0 && callSpan.Duration > 0 {
+			span.Duration = 1
+		}
+
+		spans = append(spans, span)
+	}
+
+	return spans
+}
+
+// postCallTree Function that posts a call tree to the Zipkin (or Jaeger) collector
+func postCallTree(callTree *protobuf.CallTree) error {
+	if callTree.ZipkinJSON == "" {
+		return errors.New("the call tree has no spans to post")
+	}
+
+	resp, err := zipkinClient.Post(config.GlobalConfig.ZipkinURL, "application/json", bytes.NewBufferString(callTree.ZipkinJSON))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// == //
@@ -7,15 +7,55 @@ import (
 	"log"
 	"sync"
 
+	"Operator/storage"
+
 	"github.com/Jitria/SentryFlow/protobuf"
+	"google.golang.org/protobuf/proto"
 )
 
 // == //
+
+// streamReplay structure of a stream that is sent the objects kept in the store
+type streamReplay struct {
+	replaying bool            // still sending the objects kept in the store
+	pending   []proto.Message // events that arrived during the replay
+}
+
+// replayClusterObjects Function that sends the objects kept in the store to a stream outside
+// the exporter lock, and then the events that were queued for it meanwhile
+func replayClusterObjects(objects []proto.Message, replay *streamReplay, send func(proto.Message) error) error {
+	var err error
+	for _, obj := range objects {
+		if err = send(obj); err != nil {
+			break
+		}
+	}
+
+	ExpH.exporterLock.Lock()
+	defer ExpH.exporterLock.Unlock()
+
+	for _, obj := range replay.pending {
+		if err != nil {
+			break
+		}
+		err = send(obj)
+	}
+
+	// a failed stream is dropped by the next event sent to it
+	replay.replaying = false
+	replay.pending = nil
+
+	return err
+}
+
+// == //
+
 type deployAddStreamInform struct {
 	Hostname  string
 	IPAddress string
 	stream    protobuf.SentryFlow_AddDeployEventDBServer
 	errChan   chan error
+	replay    streamReplay
 }
 
 type deployUpdateStreamInform struct {
@@ -37,6 +77,7 @@ type podAddStreamInform struct {
 	IPAddress string
 	stream    protobuf.SentryFlow_AddPodEventDBServer
 	errChan   chan error
+	replay    streamReplay
 }
 
 type podUpdateStreamInform struct {
@@ -58,6 +99,7 @@ type svcAddStreamInform struct {
 	IPAddress string
 	stream    protobuf.SentryFlow_AddSvcEventDBServer
 	errChan   chan error
+	replay    streamReplay
 }
 
 type svcUpdateStreamInform struct {
@@ -159,6 +201,118 @@ func (exp *ExpHandler) exportClusterHandler(wg *sync.WaitGroup) {
 				exp.SendServiceDelete(svc)
 			}
 
+		// StatefulSet
+		case sts := <-exp.exporterStatefulSetAdd:
+			if sts != nil {
+				exp.SendStatefulSetAdd(sts)
+			}
+		case sts := <-exp.exporterStatefulSetUpdate:
+			if sts != nil {
+				exp.SendStatefulSetUpdate(sts)
+			}
+		case sts := <-exp.exporterStatefulSetDelete:
+			if sts != nil {
+				exp.SendStatefulSetDelete(sts)
+			}
+
+		// DaemonSet
+		case ds := <-exp.exporterDaemonSetAdd:
+			if ds != nil {
+				exp.SendDaemonSetAdd(ds)
+			}
+		case ds := <-exp.exporterDaemonSetUpdate:
+			if ds != nil {
+				exp.SendDaemonSetUpdate(ds)
+			}
+		case ds := <-exp.exporterDaemonSetDelete:
+			if ds != nil {
+				exp.SendDaemonSetDelete(ds)
+			}
+
+		// Job
+		case job := <-exp.exporterJobAdd:
+			if job != nil {
+				exp.SendJobAdd(job)
+			}
+		case job := <-exp.exporterJobUpdate:
+			if job != nil {
+				exp.SendJobUpdate(job)
+			}
+		case job := <-exp.exporterJobDelete:
+			if job != nil {
+				exp.SendJobDelete(job)
+			}
+
+		// CronJob
+		case cj := <-exp.exporterCronJobAdd:
+			if cj != nil {
+				exp.SendCronJobAdd(cj)
+			}
+		case cj := <-exp.exporterCronJobUpdate:
+			if cj != nil {
+				exp.SendCronJobUpdate(cj)
+			}
+		case cj := <-exp.exporterCronJobDelete:
+			if cj != nil {
+				exp.SendCronJobDelete(cj)
+			}
+
+		// Node
+		case node := <-exp.exporterNodeAdd:
+			if node != nil {
+				exp.SendNodeAdd(node)
+			}
+		case node := <-exp.exporterNodeUpdate:
+			if node != nil {
+				exp.SendNodeUpdate(node)
+			}
+		case node := <-exp.exporterNodeDelete:
+			if node != nil {
+				exp.SendNodeDelete(node)
+			}
+
+		// Namespace
+		case ns := <-exp.exporterNamespaceAdd:
+			if ns != nil {
+				exp.SendNamespaceAdd(ns)
+			}
+		case ns := <-exp.exporterNamespaceUpdate:
+			if ns != nil {
+				exp.SendNamespaceUpdate(ns)
+			}
+		case ns := <-exp.exporterNamespaceDelete:
+			if ns != nil {
+				exp.SendNamespaceDelete(ns)
+			}
+
+		// Ingress
+		case ing := <-exp.exporterIngressAdd:
+			if ing != nil {
+				exp.SendIngressAdd(ing)
+			}
+		case ing := <-exp.exporterIngressUpdate:
+			if ing != nil {
+				exp.SendIngressUpdate(ing)
+			}
+		case ing := <-exp.exporterIngressDelete:
+			if ing != nil {
+				exp.SendIngressDelete(ing)
+			}
+
+		// ServiceEndpoints
+		case se := <-exp.exporterServiceEndpointsAdd:
+			if se != nil {
+				exp.SendServiceEndpointsAdd(se)
+			}
+		case se := <-exp.exporterServiceEndpointsUpdate:
+			if se != nil {
+				exp.SendServiceEndpointsUpdate(se)
+			}
+		case se := <-exp.exporterServiceEndpointsDelete:
+			if se != nil {
+				exp.SendServiceEndpointsDelete(se)
+			}
+
 		case <-exp.stopChan:
 			log.Print("[Exporter] Stop signal received in exportClusterHandler.")
 			return
@@ -181,6 +335,13 @@ func (exp *ExpHandler) SendDeployAdd(dep *protobuf.Deploy) error {
 	newList := make([]*deployAddStreamInform, 0, total)
 
 	for _, dsi := range exp.deployAddExporters {
+		// a stream being replayed gets the event after the replay
+		if dsi.replay.replaying {
+			dsi.replay.pending = append(dsi.replay.pending, dep)
+			newList = append(newList, dsi)
+			continue
+		}
+
 		if err := dsi.stream.Send(dep); err != nil {
 			failed++
 			log.Printf("[Exporter] Failed to send AddDeployEvent to %s (%s): %v",
@@ -265,9 +426,21 @@ func (exs *ExpService) AddDeployEventDB(info *protobuf.ClientInfo, stream protob
 	}
 
 	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("Deploy")
+	dsi.replay.replaying = true
+
 	ExpH.deployAddExporters = append(ExpH.deployAddExporters, dsi)
 	ExpH.exporterLock.Unlock()
 
+	if err := replayClusterObjects(objects, &dsi.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.Deploy))
+	}); err != nil {
+		return err
+	}
+
 	return <-dsi.errChan
 }
 
@@ -321,6 +494,13 @@ func (exp *ExpHandler) SendPodAdd(pod *protobuf.Pod) error {
 
 	newList := make([]*podAddStreamInform, 0, total)
 	for _, psi := range exp.podAddExporters {
+		// a stream being replayed gets the event after the replay
+		if psi.replay.replaying {
+			psi.replay.pending = append(psi.replay.pending, pod)
+			newList = append(newList, psi)
+			continue
+		}
+
 		if err := psi.stream.Send(pod); err != nil {
 			failed++
 			log.Printf("[Exporter] Failed to send AddPodEvent to %s (%s): %v",
@@ -401,9 +581,21 @@ func (exs *ExpService) AddPodEventDB(info *protobuf.ClientInfo, stream protobuf.
 	}
 
 	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("Pod")
+	psi.replay.replaying = true
+
 	ExpH.podAddExporters = append(ExpH.podAddExporters, psi)
 	ExpH.exporterLock.Unlock()
 
+	if err := replayClusterObjects(objects, &psi.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.Pod))
+	}); err != nil {
+		return err
+	}
+
 	return <-psi.errChan
 }
 
@@ -457,6 +649,13 @@ func (exp *ExpHandler) SendServiceAdd(svc *protobuf.Service) error {
 
 	newList := make([]*svcAddStreamInform, 0, total)
 	for _, ssi := range exp.svcAddExporters {
+		// a stream being replayed gets the event after the replay
+		if ssi.replay.replaying {
+			ssi.replay.pending = append(ssi.replay.pending, svc)
+			newList = append(newList, ssi)
+			continue
+		}
+
 		if err := ssi.stream.Send(svc); err != nil {
 			failed++
 			log.Printf("[Exporter] Failed to send AddSvcEvent to %s (%s): %v",
@@ -537,9 +736,21 @@ func (exs *ExpService) AddSvcEventDB(info *protobuf.ClientInfo, stream protobuf.
 	}
 
 	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("Service")
+	ssi.replay.replaying = true
+
 	ExpH.svcAddExporters = append(ExpH.svcAddExporters, ssi)
 	ExpH.exporterLock.Unlock()
 
+	if err := replayClusterObjects(objects, &ssi.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.Service))
+	}); err != nil {
+		return err
+	}
+
 	return <-ssi.errChan
 }
 
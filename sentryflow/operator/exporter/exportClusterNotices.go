@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// clusterNoticeStreamInform structure
+type clusterNoticeStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_GetClusterNoticeServer
+}
+
+// InsertClusterNotice Function
+func InsertClusterNotice(notice *protobuf.ClusterNotice) {
+	ExpH.exporterClusterNotices <- notice
+}
+
+// exportClusterNotices Function
+func (exp *ExpHandler) exportClusterNotices(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	for {
+		select {
+		case notice, ok := <-exp.exporterClusterNotices:
+			if !ok {
+				log.Printf("[Exporter] ClusterNotices channel closed unexpectedly")
+				return
+			}
+			if err := exp.SendClusterNotices(notice); err != nil {
+				log.Printf("[Exporter] Failed to export cluster notices: %v", err)
+			}
+
+		case <-exp.stopChan:
+			return
+		}
+	}
+}
+
+// SendClusterNotices Function
+func (exp *ExpHandler) SendClusterNotices(notice *protobuf.ClusterNotice) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.clusterNoticeExporters)
+	newList := make([]*clusterNoticeStreamInform, 0, total)
+
+	for _, exporter := range exp.clusterNoticeExporters {
+		if err := exporter.stream.Send(notice); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to export a cluster notice to %s (%s): %v",
+				exporter.Hostname, exporter.IPAddress, err)
+		} else {
+			newList = append(newList, exporter)
+		}
+	}
+
+	exp.clusterNoticeExporters = newList
+
+	if failed != 0 {
+		msg := fmt.Sprintf("[Exporter] Failed to export cluster notices properly (%d/%d failed)", failed, total)
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// GetClusterNotice Function (for gRPC)
+func (exs *ExpService) GetClusterNotice(info *protobuf.ClientInfo, stream protobuf.SentryFlow_GetClusterNoticeServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected (GetClusterNotice)", info.HostName, info.IPAddress)
+
+	currExporter := &clusterNoticeStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.clusterNoticeExporters = append(ExpH.clusterNoticeExporters, currExporter)
+	ExpH.exporterLock.Unlock()
+
+	select {}
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// connectionLogStreamInform structure
+type connectionLogStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_GetConnectionLogServer
+}
+
+// InsertConnectionLog Function
+func InsertConnectionLog(connLog *protobuf.ConnectionLog) {
+	ExpH.exporterConnectionLogs <- connLog
+}
+
+// exportConnectionLogs Function
+func (exp *ExpHandler) exportConnectionLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	for {
+		select {
+		case connLog, ok := <-exp.exporterConnectionLogs:
+			if !ok {
+				log.Printf("[Exporter] ConnectionLogs channel closed unexpectedly")
+				return
+			}
+			if err := exp.SendConnectionLogs(connLog); err != nil {
+				log.Printf("[Exporter] Failed to export connection logs: %v", err)
+			}
+
+		case <-exp.stopChan:
+			return
+		}
+	}
+}
+
+// SendConnectionLogs Function
+func (exp *ExpHandler) SendConnectionLogs(connLog *protobuf.ConnectionLog) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.connectionLogExporters)
+	newList := make([]*connectionLogStreamInform, 0, total)
+
+	for _, exporter := range exp.connectionLogExporters {
+		if err := exporter.stream.Send(connLog); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to export a connection log to %s (%s): %v",
+				exporter.Hostname, exporter.IPAddress, err)
+		} else {
+			newList = append(newList, exporter)
+		}
+	}
+
+	exp.connectionLogExporters = newList
+
+	if failed != 0 {
+		msg := fmt.Sprintf("[Exporter] Failed to export connection logs properly (%d/%d failed)", failed, total)
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// GetConnectionLog Function (for gRPC)
+func (exs *ExpService) GetConnectionLog(info *protobuf.ClientInfo, stream protobuf.SentryFlow_GetConnectionLogServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected (GetConnectionLog)", info.HostName, info.IPAddress)
+
+	currExporter := &connectionLogStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.connectionLogExporters = append(ExpH.connectionLogExporters, currExporter)
+	ExpH.exporterLock.Unlock()
+
+	select {}
+}
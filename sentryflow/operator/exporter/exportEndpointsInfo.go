@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"fmt"
+	"log"
+
+	"Operator/storage"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+type serviceEndpointsAddStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_AddServiceEndpointsEventDBServer
+	errChan   chan error
+	replay    streamReplay
+}
+
+type serviceEndpointsUpdateStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_UpdateServiceEndpointsEventDBServer
+	errChan   chan error
+}
+
+type serviceEndpointsDeleteStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_DeleteServiceEndpointsEventDBServer
+	errChan   chan error
+}
+
+func InsertServiceEndpointsAdd(se *protobuf.ServiceEndpoints) {
+	ExpH.exporterServiceEndpointsAdd <- se
+}
+
+func InsertServiceEndpointsUpdate(se *protobuf.ServiceEndpoints) {
+	ExpH.exporterServiceEndpointsUpdate <- se
+}
+
+func InsertServiceEndpointsDelete(se *protobuf.ServiceEndpoints) {
+	ExpH.exporterServiceEndpointsDelete <- se
+}
+
+//////////////////////
+// ServiceEndpoints //
+//////////////////////
+
+// SendServiceEndpointsAdd Function
+func (exp *ExpHandler) SendServiceEndpointsAdd(se *protobuf.ServiceEndpoints) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.serviceEndpointsAddExporters)
+
+	newList := make([]*serviceEndpointsAddStreamInform, 0, total)
+	for _, si := range exp.serviceEndpointsAddExporters {
+		// a stream being replayed gets the event after the replay
+		if si.replay.replaying {
+			si.replay.pending = append(si.replay.pending, se)
+			newList = append(newList, si)
+			continue
+		}
+
+		if err := si.stream.Send(se); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send AddServiceEndpointsEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.serviceEndpointsAddExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendServiceEndpointsAdd failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendServiceEndpointsUpdate Function
+func (exp *ExpHandler) SendServiceEndpointsUpdate(se *protobuf.ServiceEndpoints) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.serviceEndpointsUpdateExporters)
+
+	newList := make([]*serviceEndpointsUpdateStreamInform, 0, total)
+	for _, si := range exp.serviceEndpointsUpdateExporters {
+		if err := si.stream.Send(se); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send UpdateServiceEndpointsEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.serviceEndpointsUpdateExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendServiceEndpointsUpdate failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendServiceEndpointsDelete Function
+func (exp *ExpHandler) SendServiceEndpointsDelete(se *protobuf.ServiceEndpoints) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.serviceEndpointsDeleteExporters)
+
+	newList := make([]*serviceEndpointsDeleteStreamInform, 0, total)
+	for _, si := range exp.serviceEndpointsDeleteExporters {
+		if err := si.stream.Send(se); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send DeleteServiceEndpointsEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.serviceEndpointsDeleteExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendServiceEndpointsDelete failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// AddServiceEndpointsEventDB Function
+func (exs *ExpService) AddServiceEndpointsEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_AddServiceEndpointsEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to AddServiceEndpointsEventDB", info.HostName, info.IPAddress)
+
+	si := &serviceEndpointsAddStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("ServiceEndpoints")
+	si.replay.replaying = true
+
+	ExpH.serviceEndpointsAddExporters = append(ExpH.serviceEndpointsAddExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	if err := replayClusterObjects(objects, &si.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.ServiceEndpoints))
+	}); err != nil {
+		return err
+	}
+
+	return <-si.errChan
+}
+
+// UpdateServiceEndpointsEventDB Function
+func (exs *ExpService) UpdateServiceEndpointsEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_UpdateServiceEndpointsEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to UpdateServiceEndpointsEventDB", info.HostName, info.IPAddress)
+
+	si := &serviceEndpointsUpdateStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.serviceEndpointsUpdateExporters = append(ExpH.serviceEndpointsUpdateExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// DeleteServiceEndpointsEventDB Function
+func (exs *ExpService) DeleteServiceEndpointsEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_DeleteServiceEndpointsEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to DeleteServiceEndpointsEventDB", info.HostName, info.IPAddress)
+
+	si := &serviceEndpointsDeleteStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.serviceEndpointsDeleteExporters = append(ExpH.serviceEndpointsDeleteExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// == //
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"fmt"
+	"log"
+
+	"Operator/storage"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+type ingressAddStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_AddIngressEventDBServer
+	errChan   chan error
+	replay    streamReplay
+}
+
+type ingressUpdateStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_UpdateIngressEventDBServer
+	errChan   chan error
+}
+
+type ingressDeleteStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_DeleteIngressEventDBServer
+	errChan   chan error
+}
+
+func InsertIngressAdd(ing *protobuf.Ingress) {
+	ExpH.exporterIngressAdd <- ing
+}
+
+func InsertIngressUpdate(ing *protobuf.Ingress) {
+	ExpH.exporterIngressUpdate <- ing
+}
+
+func InsertIngressDelete(ing *protobuf.Ingress) {
+	ExpH.exporterIngressDelete <- ing
+}
+
+/////////////
+// Ingress //
+/////////////
+
+// SendIngressAdd Function
+func (exp *ExpHandler) SendIngressAdd(ing *protobuf.Ingress) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.ingressAddExporters)
+
+	newList := make([]*ingressAddStreamInform, 0, total)
+	for _, si := range exp.ingressAddExporters {
+		// a stream being replayed gets the event after the replay
+		if si.replay.replaying {
+			si.replay.pending = append(si.replay.pending, ing)
+			newList = append(newList, si)
+			continue
+		}
+
+		if err := si.stream.Send(ing); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send AddIngressEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.ingressAddExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendIngressAdd failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendIngressUpdate Function
+func (exp *ExpHandler) SendIngressUpdate(ing *protobuf.Ingress) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.ingressUpdateExporters)
+
+	newList := make([]*ingressUpdateStreamInform, 0, total)
+	for _, si := range exp.ingressUpdateExporters {
+		if err := si.stream.Send(ing); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send UpdateIngressEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.ingressUpdateExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendIngressUpdate failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendIngressDelete Function
+func (exp *ExpHandler) SendIngressDelete(ing *protobuf.Ingress) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.ingressDeleteExporters)
+
+	newList := make([]*ingressDeleteStreamInform, 0, total)
+	for _, si := range exp.ingressDeleteExporters {
+		if err := si.stream.Send(ing); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send DeleteIngressEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.ingressDeleteExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendIngressDelete failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// AddIngressEventDB Function
+func (exs *ExpService) AddIngressEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_AddIngressEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to AddIngressEventDB", info.HostName, info.IPAddress)
+
+	si := &ingressAddStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("Ingress")
+	si.replay.replaying = true
+
+	ExpH.ingressAddExporters = append(ExpH.ingressAddExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	if err := replayClusterObjects(objects, &si.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.Ingress))
+	}); err != nil {
+		return err
+	}
+
+	return <-si.errChan
+}
+
+// UpdateIngressEventDB Function
+func (exs *ExpService) UpdateIngressEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_UpdateIngressEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to UpdateIngressEventDB", info.HostName, info.IPAddress)
+
+	si := &ingressUpdateStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.ingressUpdateExporters = append(ExpH.ingressUpdateExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// DeleteIngressEventDB Function
+func (exs *ExpService) DeleteIngressEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_DeleteIngressEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to DeleteIngressEventDB", info.HostName, info.IPAddress)
+
+	si := &ingressDeleteStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.ingressDeleteExporters = append(ExpH.ingressDeleteExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// == //
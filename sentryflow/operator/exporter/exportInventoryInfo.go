@@ -0,0 +1,395 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"fmt"
+	"log"
+
+	"Operator/storage"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+type nodeAddStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_AddNodeEventDBServer
+	errChan   chan error
+	replay    streamReplay
+}
+
+type nodeUpdateStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_UpdateNodeEventDBServer
+	errChan   chan error
+}
+
+type nodeDeleteStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_DeleteNodeEventDBServer
+	errChan   chan error
+}
+
+type namespaceAddStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_AddNamespaceEventDBServer
+	errChan   chan error
+	replay    streamReplay
+}
+
+type namespaceUpdateStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_UpdateNamespaceEventDBServer
+	errChan   chan error
+}
+
+type namespaceDeleteStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_DeleteNamespaceEventDBServer
+	errChan   chan error
+}
+
+func InsertNodeAdd(node *protobuf.Node) {
+	ExpH.exporterNodeAdd <- node
+}
+
+func InsertNodeUpdate(node *protobuf.Node) {
+	ExpH.exporterNodeUpdate <- node
+}
+
+func InsertNodeDelete(node *protobuf.Node) {
+	ExpH.exporterNodeDelete <- node
+}
+
+func InsertNamespaceAdd(ns *protobuf.Namespace) {
+	ExpH.exporterNamespaceAdd <- ns
+}
+
+func InsertNamespaceUpdate(ns *protobuf.Namespace) {
+	ExpH.exporterNamespaceUpdate <- ns
+}
+
+func InsertNamespaceDelete(ns *protobuf.Namespace) {
+	ExpH.exporterNamespaceDelete <- ns
+}
+
+//////////
+// Node //
+//////////
+
+// SendNodeAdd Function
+func (exp *ExpHandler) SendNodeAdd(node *protobuf.Node) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.nodeAddExporters)
+
+	newList := make([]*nodeAddStreamInform, 0, total)
+	for _, si := range exp.nodeAddExporters {
+		// a stream being replayed gets the event after the replay
+		if si.replay.replaying {
+			si.replay.pending = append(si.replay.pending, node)
+			newList = append(newList, si)
+			continue
+		}
+
+		if err := si.stream.Send(node); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send AddNodeEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.nodeAddExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendNodeAdd failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendNodeUpdate Function
+func (exp *ExpHandler) SendNodeUpdate(node *protobuf.Node) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.nodeUpdateExporters)
+
+	newList := make([]*nodeUpdateStreamInform, 0, total)
+	for _, si := range exp.nodeUpdateExporters {
+		if err := si.stream.Send(node); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send UpdateNodeEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.nodeUpdateExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendNodeUpdate failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendNodeDelete Function
+func (exp *ExpHandler) SendNodeDelete(node *protobuf.Node) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.nodeDeleteExporters)
+
+	newList := make([]*nodeDeleteStreamInform, 0, total)
+	for _, si := range exp.nodeDeleteExporters {
+		if err := si.stream.Send(node); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send DeleteNodeEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.nodeDeleteExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendNodeDelete failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// AddNodeEventDB Function
+func (exs *ExpService) AddNodeEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_AddNodeEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to AddNodeEventDB", info.HostName, info.IPAddress)
+
+	si := &nodeAddStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("Node")
+	si.replay.replaying = true
+
+	ExpH.nodeAddExporters = append(ExpH.nodeAddExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	if err := replayClusterObjects(objects, &si.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.Node))
+	}); err != nil {
+		return err
+	}
+
+	return <-si.errChan
+}
+
+// UpdateNodeEventDB Function
+func (exs *ExpService) UpdateNodeEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_UpdateNodeEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to UpdateNodeEventDB", info.HostName, info.IPAddress)
+
+	si := &nodeUpdateStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.nodeUpdateExporters = append(ExpH.nodeUpdateExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// DeleteNodeEventDB Function
+func (exs *ExpService) DeleteNodeEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_DeleteNodeEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to DeleteNodeEventDB", info.HostName, info.IPAddress)
+
+	si := &nodeDeleteStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.nodeDeleteExporters = append(ExpH.nodeDeleteExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+///////////////
+// Namespace //
+///////////////
+
+// SendNamespaceAdd Function
+func (exp *ExpHandler) SendNamespaceAdd(ns *protobuf.Namespace) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.namespaceAddExporters)
+
+	newList := make([]*namespaceAddStreamInform, 0, total)
+	for _, si := range exp.namespaceAddExporters {
+		// a stream being replayed gets the event after the replay
+		if si.replay.replaying {
+			si.replay.pending = append(si.replay.pending, ns)
+			newList = append(newList, si)
+			continue
+		}
+
+		if err := si.stream.Send(ns); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send AddNamespaceEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.namespaceAddExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendNamespaceAdd failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendNamespaceUpdate Function
+func (exp *ExpHandler) SendNamespaceUpdate(ns *protobuf.Namespace) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.namespaceUpdateExporters)
+
+	newList := make([]*namespaceUpdateStreamInform, 0, total)
+	for _, si := range exp.namespaceUpdateExporters {
+		if err := si.stream.Send(ns); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send UpdateNamespaceEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.namespaceUpdateExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendNamespaceUpdate failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendNamespaceDelete Function
+func (exp *ExpHandler) SendNamespaceDelete(ns *protobuf.Namespace) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.namespaceDeleteExporters)
+
+	newList := make([]*namespaceDeleteStreamInform, 0, total)
+	for _, si := range exp.namespaceDeleteExporters {
+		if err := si.stream.Send(ns); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send DeleteNamespaceEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.namespaceDeleteExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendNamespaceDelete failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// AddNamespaceEventDB Function
+func (exs *ExpService) AddNamespaceEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_AddNamespaceEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to AddNamespaceEventDB", info.HostName, info.IPAddress)
+
+	si := &namespaceAddStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("Namespace")
+	si.replay.replaying = true
+
+	ExpH.namespaceAddExporters = append(ExpH.namespaceAddExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	if err := replayClusterObjects(objects, &si.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.Namespace))
+	}); err != nil {
+		return err
+	}
+
+	return <-si.errChan
+}
+
+// UpdateNamespaceEventDB Function
+func (exs *ExpService) UpdateNamespaceEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_UpdateNamespaceEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to UpdateNamespaceEventDB", info.HostName, info.IPAddress)
+
+	si := &namespaceUpdateStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.namespaceUpdateExporters = append(ExpH.namespaceUpdateExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// DeleteNamespaceEventDB Function
+func (exs *ExpService) DeleteNamespaceEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_DeleteNamespaceEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to DeleteNamespaceEventDB", info.HostName, info.IPAddress)
+
+	si := &namespaceDeleteStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.namespaceDeleteExporters = append(ExpH.namespaceDeleteExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// == //
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"context"
+	"log"
+
+	"Operator/graph"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// == //
+
+// GetServiceGraph Function (for gRPC)
+func (exs *ExpService) GetServiceGraph(ctx context.Context, query *protobuf.ServiceGraphQuery) (*protobuf.ServiceGraph, error) {
+	if query.Window < 0 {
+		return nil, status.Error(codes.InvalidArgument, "window is negative")
+	}
+
+	return graph.GetServiceGraph(query), nil
+}
+
+// WatchServiceGraph Function (for gRPC)
+func (exs *ExpService) WatchServiceGraph(query *protobuf.ServiceGraphQuery, svr protobuf.SentryFlow_WatchServiceGraphServer) error {
+	if query.Window < 0 {
+		return status.Error(codes.InvalidArgument, "window is negative")
+	}
+
+	watcher, initial := graph.Watch(query)
+	defer graph.Unwatch(watcher)
+
+	log.Printf("[Exporter] Started watching the service graph (window=%ds, cluster=%q, namespace=%q)",
+		query.Window, query.Cluster, query.Namespace)
+
+	// The current graph first, and then what changes
+	for _, change := range initial {
+		if err := svr.Send(change); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case change, ok := <-watcher.Changes():
+			if !ok {
+				log.Printf("[Exporter] Stopped watching the service graph: %v", watcher.Err())
+				return status.Error(codes.Unavailable, watcher.Err().Error())
+			}
+			if err := svr.Send(change); err != nil {
+				return err
+			}
+
+		case <-svr.Context().Done():
+			return nil
+		}
+	}
+}
+
+// == //
@@ -0,0 +1,773 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"fmt"
+	"log"
+
+	"Operator/storage"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+type statefulSetAddStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_AddStatefulSetEventDBServer
+	errChan   chan error
+	replay    streamReplay
+}
+
+type statefulSetUpdateStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_UpdateStatefulSetEventDBServer
+	errChan   chan error
+}
+
+type statefulSetDeleteStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_DeleteStatefulSetEventDBServer
+	errChan   chan error
+}
+
+type daemonSetAddStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_AddDaemonSetEventDBServer
+	errChan   chan error
+	replay    streamReplay
+}
+
+type daemonSetUpdateStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_UpdateDaemonSetEventDBServer
+	errChan   chan error
+}
+
+type daemonSetDeleteStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_DeleteDaemonSetEventDBServer
+	errChan   chan error
+}
+
+type jobAddStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_AddJobEventDBServer
+	errChan   chan error
+	replay    streamReplay
+}
+
+type jobUpdateStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_UpdateJobEventDBServer
+	errChan   chan error
+}
+
+type jobDeleteStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_DeleteJobEventDBServer
+	errChan   chan error
+}
+
+type cronJobAddStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_AddCronJobEventDBServer
+	errChan   chan error
+	replay    streamReplay
+}
+
+type cronJobUpdateStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_UpdateCronJobEventDBServer
+	errChan   chan error
+}
+
+type cronJobDeleteStreamInform struct {
+	Hostname  string
+	IPAddress string
+	stream    protobuf.SentryFlow_DeleteCronJobEventDBServer
+	errChan   chan error
+}
+
+func InsertStatefulSetAdd(sts *protobuf.StatefulSet) {
+	ExpH.exporterStatefulSetAdd <- sts
+}
+
+func InsertStatefulSetUpdate(sts *protobuf.StatefulSet) {
+	ExpH.exporterStatefulSetUpdate <- sts
+}
+
+func InsertStatefulSetDelete(sts *protobuf.StatefulSet) {
+	ExpH.exporterStatefulSetDelete <- sts
+}
+
+func InsertDaemonSetAdd(ds *protobuf.DaemonSet) {
+	ExpH.exporterDaemonSetAdd <- ds
+}
+
+func InsertDaemonSetUpdate(ds *protobuf.DaemonSet) {
+	ExpH.exporterDaemonSetUpdate <- ds
+}
+
+func InsertDaemonSetDelete(ds *protobuf.DaemonSet) {
+	ExpH.exporterDaemonSetDelete <- ds
+}
+
+func InsertJobAdd(job *protobuf.Job) {
+	ExpH.exporterJobAdd <- job
+}
+
+func InsertJobUpdate(job *protobuf.Job) {
+	ExpH.exporterJobUpdate <- job
+}
+
+func InsertJobDelete(job *protobuf.Job) {
+	ExpH.exporterJobDelete <- job
+}
+
+func InsertCronJobAdd(cj *protobuf.CronJob) {
+	ExpH.exporterCronJobAdd <- cj
+}
+
+func InsertCronJobUpdate(cj *protobuf.CronJob) {
+	ExpH.exporterCronJobUpdate <- cj
+}
+
+func InsertCronJobDelete(cj *protobuf.CronJob) {
+	ExpH.exporterCronJobDelete <- cj
+}
+
+/////////////////
+// StatefulSet //
+/////////////////
+
+// SendStatefulSetAdd Function
+func (exp *ExpHandler) SendStatefulSetAdd(sts *protobuf.StatefulSet) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.statefulSetAddExporters)
+
+	newList := make([]*statefulSetAddStreamInform, 0, total)
+	for _, si := range exp.statefulSetAddExporters {
+		// a stream being replayed gets the event after the replay
+		if si.replay.replaying {
+			si.replay.pending = append(si.replay.pending, sts)
+			newList = append(newList, si)
+			continue
+		}
+
+		if err := si.stream.Send(sts); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send AddStatefulSetEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.statefulSetAddExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendStatefulSetAdd failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendStatefulSetUpdate Function
+func (exp *ExpHandler) SendStatefulSetUpdate(sts *protobuf.StatefulSet) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.statefulSetUpdateExporters)
+
+	newList := make([]*statefulSetUpdateStreamInform, 0, total)
+	for _, si := range exp.statefulSetUpdateExporters {
+		if err := si.stream.Send(sts); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send UpdateStatefulSetEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.statefulSetUpdateExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendStatefulSetUpdate failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendStatefulSetDelete Function
+func (exp *ExpHandler) SendStatefulSetDelete(sts *protobuf.StatefulSet) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.statefulSetDeleteExporters)
+
+	newList := make([]*statefulSetDeleteStreamInform, 0, total)
+	for _, si := range exp.statefulSetDeleteExporters {
+		if err := si.stream.Send(sts); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send DeleteStatefulSetEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.statefulSetDeleteExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendStatefulSetDelete failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// AddStatefulSetEventDB Function
+func (exs *ExpService) AddStatefulSetEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_AddStatefulSetEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to AddStatefulSetEventDB", info.HostName, info.IPAddress)
+
+	si := &statefulSetAddStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("StatefulSet")
+	si.replay.replaying = true
+
+	ExpH.statefulSetAddExporters = append(ExpH.statefulSetAddExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	if err := replayClusterObjects(objects, &si.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.StatefulSet))
+	}); err != nil {
+		return err
+	}
+
+	return <-si.errChan
+}
+
+// UpdateStatefulSetEventDB Function
+func (exs *ExpService) UpdateStatefulSetEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_UpdateStatefulSetEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to UpdateStatefulSetEventDB", info.HostName, info.IPAddress)
+
+	si := &statefulSetUpdateStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.statefulSetUpdateExporters = append(ExpH.statefulSetUpdateExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// DeleteStatefulSetEventDB Function
+func (exs *ExpService) DeleteStatefulSetEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_DeleteStatefulSetEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to DeleteStatefulSetEventDB", info.HostName, info.IPAddress)
+
+	si := &statefulSetDeleteStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.statefulSetDeleteExporters = append(ExpH.statefulSetDeleteExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+///////////////
+// DaemonSet //
+///////////////
+
+// SendDaemonSetAdd Function
+func (exp *ExpHandler) SendDaemonSetAdd(ds *protobuf.DaemonSet) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.daemonSetAddExporters)
+
+	newList := make([]*daemonSetAddStreamInform, 0, total)
+	for _, si := range exp.daemonSetAddExporters {
+		// a stream being replayed gets the event after the replay
+		if si.replay.replaying {
+			si.replay.pending = append(si.replay.pending, ds)
+			newList = append(newList, si)
+			continue
+		}
+
+		if err := si.stream.Send(ds); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send AddDaemonSetEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.daemonSetAddExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendDaemonSetAdd failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendDaemonSetUpdate Function
+func (exp *ExpHandler) SendDaemonSetUpdate(ds *protobuf.DaemonSet) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.daemonSetUpdateExporters)
+
+	newList := make([]*daemonSetUpdateStreamInform, 0, total)
+	for _, si := range exp.daemonSetUpdateExporters {
+		if err := si.stream.Send(ds); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send UpdateDaemonSetEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.daemonSetUpdateExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendDaemonSetUpdate failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendDaemonSetDelete Function
+func (exp *ExpHandler) SendDaemonSetDelete(ds *protobuf.DaemonSet) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.daemonSetDeleteExporters)
+
+	newList := make([]*daemonSetDeleteStreamInform, 0, total)
+	for _, si := range exp.daemonSetDeleteExporters {
+		if err := si.stream.Send(ds); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send DeleteDaemonSetEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.daemonSetDeleteExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendDaemonSetDelete failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// AddDaemonSetEventDB Function
+func (exs *ExpService) AddDaemonSetEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_AddDaemonSetEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to AddDaemonSetEventDB", info.HostName, info.IPAddress)
+
+	si := &daemonSetAddStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("DaemonSet")
+	si.replay.replaying = true
+
+	ExpH.daemonSetAddExporters = append(ExpH.daemonSetAddExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	if err := replayClusterObjects(objects, &si.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.DaemonSet))
+	}); err != nil {
+		return err
+	}
+
+	return <-si.errChan
+}
+
+// UpdateDaemonSetEventDB Function
+func (exs *ExpService) UpdateDaemonSetEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_UpdateDaemonSetEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to UpdateDaemonSetEventDB", info.HostName, info.IPAddress)
+
+	si := &daemonSetUpdateStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.daemonSetUpdateExporters = append(ExpH.daemonSetUpdateExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// DeleteDaemonSetEventDB Function
+func (exs *ExpService) DeleteDaemonSetEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_DeleteDaemonSetEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to DeleteDaemonSetEventDB", info.HostName, info.IPAddress)
+
+	si := &daemonSetDeleteStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.daemonSetDeleteExporters = append(ExpH.daemonSetDeleteExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+/////////
+// Job //
+/////////
+
+// SendJobAdd Function
+func (exp *ExpHandler) SendJobAdd(job *protobuf.Job) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.jobAddExporters)
+
+	newList := make([]*jobAddStreamInform, 0, total)
+	for _, si := range exp.jobAddExporters {
+		// a stream being replayed gets the event after the replay
+		if si.replay.replaying {
+			si.replay.pending = append(si.replay.pending, job)
+			newList = append(newList, si)
+			continue
+		}
+
+		if err := si.stream.Send(job); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send AddJobEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.jobAddExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendJobAdd failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendJobUpdate Function
+func (exp *ExpHandler) SendJobUpdate(job *protobuf.Job) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.jobUpdateExporters)
+
+	newList := make([]*jobUpdateStreamInform, 0, total)
+	for _, si := range exp.jobUpdateExporters {
+		if err := si.stream.Send(job); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send UpdateJobEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.jobUpdateExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendJobUpdate failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendJobDelete Function
+func (exp *ExpHandler) SendJobDelete(job *protobuf.Job) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.jobDeleteExporters)
+
+	newList := make([]*jobDeleteStreamInform, 0, total)
+	for _, si := range exp.jobDeleteExporters {
+		if err := si.stream.Send(job); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send DeleteJobEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.jobDeleteExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendJobDelete failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// AddJobEventDB Function
+func (exs *ExpService) AddJobEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_AddJobEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to AddJobEventDB", info.HostName, info.IPAddress)
+
+	si := &jobAddStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("Job")
+	si.replay.replaying = true
+
+	ExpH.jobAddExporters = append(ExpH.jobAddExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	if err := replayClusterObjects(objects, &si.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.Job))
+	}); err != nil {
+		return err
+	}
+
+	return <-si.errChan
+}
+
+// UpdateJobEventDB Function
+func (exs *ExpService) UpdateJobEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_UpdateJobEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to UpdateJobEventDB", info.HostName, info.IPAddress)
+
+	si := &jobUpdateStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.jobUpdateExporters = append(ExpH.jobUpdateExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// DeleteJobEventDB Function
+func (exs *ExpService) DeleteJobEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_DeleteJobEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to DeleteJobEventDB", info.HostName, info.IPAddress)
+
+	si := &jobDeleteStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.jobDeleteExporters = append(ExpH.jobDeleteExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+/////////////
+// CronJob //
+/////////////
+
+// SendCronJobAdd Function
+func (exp *ExpHandler) SendCronJobAdd(cj *protobuf.CronJob) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.cronJobAddExporters)
+
+	newList := make([]*cronJobAddStreamInform, 0, total)
+	for _, si := range exp.cronJobAddExporters {
+		// a stream being replayed gets the event after the replay
+		if si.replay.replaying {
+			si.replay.pending = append(si.replay.pending, cj)
+			newList = append(newList, si)
+			continue
+		}
+
+		if err := si.stream.Send(cj); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send AddCronJobEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.cronJobAddExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendCronJobAdd failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendCronJobUpdate Function
+func (exp *ExpHandler) SendCronJobUpdate(cj *protobuf.CronJob) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.cronJobUpdateExporters)
+
+	newList := make([]*cronJobUpdateStreamInform, 0, total)
+	for _, si := range exp.cronJobUpdateExporters {
+		if err := si.stream.Send(cj); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send UpdateCronJobEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.cronJobUpdateExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendCronJobUpdate failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// SendCronJobDelete Function
+func (exp *ExpHandler) SendCronJobDelete(cj *protobuf.CronJob) error {
+	exp.exporterLock.Lock()
+	defer exp.exporterLock.Unlock()
+
+	failed := 0
+	total := len(exp.cronJobDeleteExporters)
+
+	newList := make([]*cronJobDeleteStreamInform, 0, total)
+	for _, si := range exp.cronJobDeleteExporters {
+		if err := si.stream.Send(cj); err != nil {
+			failed++
+			log.Printf("[Exporter] Failed to send DeleteCronJobEvent to %s (%s): %v",
+				si.Hostname, si.IPAddress, err)
+		} else {
+			newList = append(newList, si)
+		}
+	}
+	exp.cronJobDeleteExporters = newList
+
+	if failed > 0 {
+		return fmt.Errorf("SendCronJobDelete failed: %d/%d", failed, total)
+	}
+	return nil
+}
+
+// AddCronJobEventDB Function
+func (exs *ExpService) AddCronJobEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_AddCronJobEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to AddCronJobEventDB", info.HostName, info.IPAddress)
+
+	si := &cronJobAddStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+
+	// Clients that connect later start from the objects kept in the store,
+	// which are sent after releasing the lock (events meanwhile are queued)
+	objects := storage.LoadClusterObjects("CronJob")
+	si.replay.replaying = true
+
+	ExpH.cronJobAddExporters = append(ExpH.cronJobAddExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	if err := replayClusterObjects(objects, &si.replay, func(obj proto.Message) error {
+		return stream.Send(obj.(*protobuf.CronJob))
+	}); err != nil {
+		return err
+	}
+
+	return <-si.errChan
+}
+
+// UpdateCronJobEventDB Function
+func (exs *ExpService) UpdateCronJobEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_UpdateCronJobEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to UpdateCronJobEventDB", info.HostName, info.IPAddress)
+
+	si := &cronJobUpdateStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.cronJobUpdateExporters = append(ExpH.cronJobUpdateExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// DeleteCronJobEventDB Function
+func (exs *ExpService) DeleteCronJobEventDB(info *protobuf.ClientInfo, stream protobuf.SentryFlow_DeleteCronJobEventDBServer) error {
+	log.Printf("[Exporter] Client %s (%s) connected to DeleteCronJobEventDB", info.HostName, info.IPAddress)
+
+	si := &cronJobDeleteStreamInform{
+		Hostname:  info.HostName,
+		IPAddress: info.IPAddress,
+		stream:    stream,
+		errChan:   make(chan error),
+	}
+
+	ExpH.exporterLock.Lock()
+	ExpH.cronJobDeleteExporters = append(ExpH.cronJobDeleteExporters, si)
+	ExpH.exporterLock.Unlock()
+
+	return <-si.errChan
+}
+
+// == //
@@ -32,8 +32,12 @@ type ExpHandler struct {
 	grpcServer      *grpc.Server
 	grpcService     *ExpService
 
-	apiLogExporters       []*apiLogStreamInform
-	envoyMetricsExporters []*envoyMetricsStreamInform
+	apiLogExporters        []*apiLogStreamInform
+	envoyMetricsExporters  []*envoyMetricsStreamInform
+	connectionLogExporters []*connectionLogStreamInform
+	callTreeExporters      []*callTreeStreamInform
+	auditLogExporters      []*auditLogStreamInform
+	clusterNoticeExporters []*clusterNoticeStreamInform
 
 	deployAddExporters    []*deployAddStreamInform
 	deployUpdateExporters []*deployUpdateStreamInform
@@ -47,10 +51,50 @@ type ExpHandler struct {
 	svcUpdateExporters []*svcUpdateStreamInform
 	svcDeleteExporters []*svcDeleteStreamInform
 
+	statefulSetAddExporters    []*statefulSetAddStreamInform
+	statefulSetUpdateExporters []*statefulSetUpdateStreamInform
+	statefulSetDeleteExporters []*statefulSetDeleteStreamInform
+
+	daemonSetAddExporters    []*daemonSetAddStreamInform
+	daemonSetUpdateExporters []*daemonSetUpdateStreamInform
+	daemonSetDeleteExporters []*daemonSetDeleteStreamInform
+
+	jobAddExporters    []*jobAddStreamInform
+	jobUpdateExporters []*jobUpdateStreamInform
+	jobDeleteExporters []*jobDeleteStreamInform
+
+	cronJobAddExporters    []*cronJobAddStreamInform
+	cronJobUpdateExporters []*cronJobUpdateStreamInform
+	cronJobDeleteExporters []*cronJobDeleteStreamInform
+
+	nodeAddExporters    []*nodeAddStreamInform
+	nodeUpdateExporters []*nodeUpdateStreamInform
+	nodeDeleteExporters []*nodeDeleteStreamInform
+
+	namespaceAddExporters    []*namespaceAddStreamInform
+	namespaceUpdateExporters []*namespaceUpdateStreamInform
+	namespaceDeleteExporters []*namespaceDeleteStreamInform
+
+	ingressAddExporters    []*ingressAddStreamInform
+	ingressUpdateExporters []*ingressUpdateStreamInform
+	ingressDeleteExporters []*ingressDeleteStreamInform
+
+	serviceEndpointsAddExporters    []*serviceEndpointsAddStreamInform
+	serviceEndpointsUpdateExporters []*serviceEndpointsUpdateStreamInform
+	serviceEndpointsDeleteExporters []*serviceEndpointsDeleteStreamInform
+
 	exporterLock sync.Mutex
 
-	exporterAPILogs chan *protobuf.APILog
-	exporterMetrics chan *protobuf.EnvoyMetrics
+	agents    map[string]*agentEntry
+	agentLock sync.Mutex
+
+	exporterAPILogs        chan *protobuf.APILog
+	exporterMetrics        chan *protobuf.EnvoyMetrics
+	exporterConnectionLogs chan *protobuf.ConnectionLog
+	exporterCallTrees      chan *protobuf.CallTree
+	zipkinQueue            chan *protobuf.CallTree
+	exporterAuditLogs      chan *protobuf.AuditLog
+	exporterClusterNotices chan *protobuf.ClusterNotice
 
 	exporterDeployAdd    chan *protobuf.Deploy
 	exporterDeployUpdate chan *protobuf.Deploy
@@ -64,6 +108,38 @@ type ExpHandler struct {
 	exporterSvcUpdate chan *protobuf.Service
 	exporterSvcDelete chan *protobuf.Service
 
+	exporterStatefulSetAdd    chan *protobuf.StatefulSet
+	exporterStatefulSetUpdate chan *protobuf.StatefulSet
+	exporterStatefulSetDelete chan *protobuf.StatefulSet
+
+	exporterDaemonSetAdd    chan *protobuf.DaemonSet
+	exporterDaemonSetUpdate chan *protobuf.DaemonSet
+	exporterDaemonSetDelete chan *protobuf.DaemonSet
+
+	exporterJobAdd    chan *protobuf.Job
+	exporterJobUpdate chan *protobuf.Job
+	exporterJobDelete chan *protobuf.Job
+
+	exporterCronJobAdd    chan *protobuf.CronJob
+	exporterCronJobUpdate chan *protobuf.CronJob
+	exporterCronJobDelete chan *protobuf.CronJob
+
+	exporterNodeAdd    chan *protobuf.Node
+	exporterNodeUpdate chan *protobuf.Node
+	exporterNodeDelete chan *protobuf.Node
+
+	exporterNamespaceAdd    chan *protobuf.Namespace
+	exporterNamespaceUpdate chan *protobuf.Namespace
+	exporterNamespaceDelete chan *protobuf.Namespace
+
+	exporterIngressAdd    chan *protobuf.Ingress
+	exporterIngressUpdate chan *protobuf.Ingress
+	exporterIngressDelete chan *protobuf.Ingress
+
+	exporterServiceEndpointsAdd    chan *protobuf.ServiceEndpoints
+	exporterServiceEndpointsUpdate chan *protobuf.ServiceEndpoints
+	exporterServiceEndpointsDelete chan *protobuf.ServiceEndpoints
+
 	stopChan chan struct{}
 }
 
@@ -79,8 +155,12 @@ func NewExporterHandler() *ExpHandler {
 	exp := &ExpHandler{
 		grpcService: new(ExpService),
 
-		apiLogExporters:       make([]*apiLogStreamInform, 0),
-		envoyMetricsExporters: make([]*envoyMetricsStreamInform, 0),
+		apiLogExporters:        make([]*apiLogStreamInform, 0),
+		envoyMetricsExporters:  make([]*envoyMetricsStreamInform, 0),
+		connectionLogExporters: make([]*connectionLogStreamInform, 0),
+		callTreeExporters:      make([]*callTreeStreamInform, 0),
+		auditLogExporters:      make([]*auditLogStreamInform, 0),
+		clusterNoticeExporters: make([]*clusterNoticeStreamInform, 0),
 
 		deployAddExporters:    make([]*deployAddStreamInform, 0),
 		deployUpdateExporters: make([]*deployUpdateStreamInform, 0),
@@ -94,10 +174,49 @@ func NewExporterHandler() *ExpHandler {
 		svcUpdateExporters: make([]*svcUpdateStreamInform, 0),
 		svcDeleteExporters: make([]*svcDeleteStreamInform, 0),
 
+		statefulSetAddExporters:    make([]*statefulSetAddStreamInform, 0),
+		statefulSetUpdateExporters: make([]*statefulSetUpdateStreamInform, 0),
+		statefulSetDeleteExporters: make([]*statefulSetDeleteStreamInform, 0),
+
+		daemonSetAddExporters:    make([]*daemonSetAddStreamInform, 0),
+		daemonSetUpdateExporters: make([]*daemonSetUpdateStreamInform, 0),
+		daemonSetDeleteExporters: make([]*daemonSetDeleteStreamInform, 0),
+
+		jobAddExporters:    make([]*jobAddStreamInform, 0),
+		jobUpdateExporters: make([]*jobUpdateStreamInform, 0),
+		jobDeleteExporters: make([]*jobDeleteStreamInform, 0),
+
+		cronJobAddExporters:    make([]*cronJobAddStreamInform, 0),
+		cronJobUpdateExporters: make([]*cronJobUpdateStreamInform, 0),
+		cronJobDeleteExporters: make([]*cronJobDeleteStreamInform, 0),
+
+		nodeAddExporters:    make([]*nodeAddStreamInform, 0),
+		nodeUpdateExporters: make([]*nodeUpdateStreamInform, 0),
+		nodeDeleteExporters: make([]*nodeDeleteStreamInform, 0),
+
+		namespaceAddExporters:    make([]*namespaceAddStreamInform, 0),
+		namespaceUpdateExporters: make([]*namespaceUpdateStreamInform, 0),
+		namespaceDeleteExporters: make([]*namespaceDeleteStreamInform, 0),
+
+		ingressAddExporters:    make([]*ingressAddStreamInform, 0),
+		ingressUpdateExporters: make([]*ingressUpdateStreamInform, 0),
+		ingressDeleteExporters: make([]*ingressDeleteStreamInform, 0),
+
+		serviceEndpointsAddExporters:    make([]*serviceEndpointsAddStreamInform, 0),
+		serviceEndpointsUpdateExporters: make([]*serviceEndpointsUpdateStreamInform, 0),
+		serviceEndpointsDeleteExporters: make([]*serviceEndpointsDeleteStreamInform, 0),
+
 		exporterLock: sync.Mutex{},
 
-		exporterAPILogs: make(chan *protobuf.APILog),
-		exporterMetrics: make(chan *protobuf.EnvoyMetrics),
+		agents: make(map[string]*agentEntry),
+
+		exporterAPILogs:        make(chan *protobuf.APILog),
+		exporterMetrics:        make(chan *protobuf.EnvoyMetrics),
+		exporterConnectionLogs: make(chan *protobuf.ConnectionLog),
+		exporterCallTrees:      make(chan *protobuf.CallTree),
+		zipkinQueue:            make(chan *protobuf.CallTree, zipkinQueueSize),
+		exporterAuditLogs:      make(chan *protobuf.AuditLog),
+		exporterClusterNotices: make(chan *protobuf.ClusterNotice),
 
 		exporterDeployAdd:    make(chan *protobuf.Deploy),
 		exporterDeployUpdate: make(chan *protobuf.Deploy),
@@ -111,6 +230,38 @@ func NewExporterHandler() *ExpHandler {
 		exporterSvcUpdate: make(chan *protobuf.Service),
 		exporterSvcDelete: make(chan *protobuf.Service),
 
+		exporterStatefulSetAdd:    make(chan *protobuf.StatefulSet),
+		exporterStatefulSetUpdate: make(chan *protobuf.StatefulSet),
+		exporterStatefulSetDelete: make(chan *protobuf.StatefulSet),
+
+		exporterDaemonSetAdd:    make(chan *protobuf.DaemonSet),
+		exporterDaemonSetUpdate: make(chan *protobuf.DaemonSet),
+		exporterDaemonSetDelete: make(chan *protobuf.DaemonSet),
+
+		exporterJobAdd:    make(chan *protobuf.Job),
+		exporterJobUpdate: make(chan *protobuf.Job),
+		exporterJobDelete: make(chan *protobuf.Job),
+
+		exporterCronJobAdd:    make(chan *protobuf.CronJob),
+		exporterCronJobUpdate: make(chan *protobuf.CronJob),
+		exporterCronJobDelete: make(chan *protobuf.CronJob),
+
+		exporterNodeAdd:    make(chan *protobuf.Node),
+		exporterNodeUpdate: make(chan *protobuf.Node),
+		exporterNodeDelete: make(chan *protobuf.Node),
+
+		exporterNamespaceAdd:    make(chan *protobuf.Namespace),
+		exporterNamespaceUpdate: make(chan *protobuf.Namespace),
+		exporterNamespaceDelete: make(chan *protobuf.Namespace),
+
+		exporterIngressAdd:    make(chan *protobuf.Ingress),
+		exporterIngressUpdate: make(chan *protobuf.Ingress),
+		exporterIngressDelete: make(chan *protobuf.Ingress),
+
+		exporterServiceEndpointsAdd:    make(chan *protobuf.ServiceEndpoints),
+		exporterServiceEndpointsUpdate: make(chan *protobuf.ServiceEndpoints),
+		exporterServiceEndpointsDelete: make(chan *protobuf.ServiceEndpoints),
+
 		stopChan: make(chan struct{}),
 	}
 
@@ -162,6 +313,34 @@ func StartExporter(wg *sync.WaitGroup) bool {
 
 	log.Printf("[Exporter] Exporting Envoy metrics through gRPC services")
 
+	// Export ConnectionLogs
+	go ExpH.exportConnectionLogs(wg)
+
+	log.Printf("[Exporter] Exporting connection logs through gRPC services")
+
+	// Export CallTrees
+	go ExpH.exportCallTrees(wg)
+
+	log.Printf("[Exporter] Exporting call trees through gRPC services")
+
+	// Post CallTrees to Zipkin
+	go ExpH.postZipkinSpans(wg)
+
+	// Export AuditLogs
+	go ExpH.exportAuditLogs(wg)
+
+	log.Printf("[Exporter] Exporting audit logs through gRPC services")
+
+	// Export ClusterNotices
+	go ExpH.exportClusterNotices(wg)
+
+	log.Printf("[Exporter] Exporting cluster notices through gRPC services")
+
+	// Watch the heartbeats of registered agents
+	go ExpH.watchAgents(wg)
+
+	log.Printf("[Exporter] Watching agent heartbeats (stale after %d seconds)", config.GlobalConfig.AgentStaleTimeout)
+
 	return true
 }
 
@@ -176,6 +355,24 @@ func StopExporter() bool {
 	// One for exportEnvoyMetrics
 	ExpH.stopChan <- struct{}{}
 
+	// One for exportConnectionLogs
+	ExpH.stopChan <- struct{}{}
+
+	// One for exportCallTrees
+	ExpH.stopChan <- struct{}{}
+
+	// One for postZipkinSpans
+	ExpH.stopChan <- struct{}{}
+
+	// One for exportAuditLogs
+	ExpH.stopChan <- struct{}{}
+
+	// One for exportClusterNotices
+	ExpH.stopChan <- struct{}{}
+
+	// One for watchAgents
+	ExpH.stopChan <- struct{}{}
+
 	// Stop gRPC server
 	ExpH.grpcServer.GracefulStop()
 
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"Operator/config"
+	"Operator/storage"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// GraH global reference for Graph Handler
+var GraH *GraphHandler
+
+// init Function
+func init() {
+	GraH = NewGraphHandler()
+}
+
+// GraphHandler Structure
+type GraphHandler struct {
+	lock sync.Mutex
+
+	nodes map[string]*graphNode // key: node ID
+	edges map[string]*graphEdge // key: edge ID
+
+	updatedEdges map[string]struct{} // edges with traffic since the last update of watchers
+	watchers     map[*Watcher]struct{}
+
+	retention    time.Duration
+	updatePeriod time.Duration
+
+	stopChan chan struct{}
+}
+
+// NewGraphHandler Function
+func NewGraphHandler() *GraphHandler {
+	gh := &GraphHandler{
+		nodes: make(map[string]*graphNode),
+		edges: make(map[string]*graphEdge),
+
+		updatedEdges: make(map[string]struct{}),
+		watchers:     make(map[*Watcher]struct{}),
+
+		retention:    time.Hour,
+		updatePeriod: 5 * time.Second,
+
+		stopChan: make(chan struct{}),
+	}
+
+	return gh
+}
+
+// == //
+
+// StartGraph Function
+func StartGraph(wg *sync.WaitGroup) bool {
+	if config.GlobalConfig.GraphRetention > 0 {
+		GraH.retention = time.Duration(config.GlobalConfig.GraphRetention) * time.Minute
+	}
+	if config.GlobalConfig.GraphUpdatePeriod > 0 {
+		GraH.updatePeriod = time.Duration(config.GlobalConfig.GraphUpdatePeriod) * time.Second
+	}
+
+	// Rebuild the graph from the API logs that were stored before a restart
+	GraH.restore(time.Now())
+
+	// Expire old traffic and send changes to watchers
+	go GraH.updateGraph(wg)
+
+	log.Printf("[Graph] Started the service graph (retention=%v, updatePeriod=%v)", GraH.retention, GraH.updatePeriod)
+
+	return true
+}
+
+// StopGraph Function
+func StopGraph() bool {
+	GraH.stopChan <- struct{}{}
+
+	GraH.lock.Lock()
+	defer GraH.lock.Unlock()
+
+	for watcher := range GraH.watchers {
+		watcher.close(errGraphStopped)
+	}
+
+	log.Print("[Graph] Stopped the service graph")
+
+	return true
+}
+
+// == //
+
+// RecordAPILog Function that adds the call of an API log to the service graph
+func RecordAPILog(apiLog *protobuf.APILog) {
+	GraH.record(apiLog, time.Now())
+}
+
+// GetServiceGraph Function that returns the part of the service graph that a query asks for
+func GetServiceGraph(query *protobuf.ServiceGraphQuery) *protobuf.ServiceGraph {
+	GraH.lock.Lock()
+	defer GraH.lock.Unlock()
+
+	return GraH.snapshot(query, time.Now())
+}
+
+// == //
+
+// restore Function that records the stored API logs within the retention
+func (gh *GraphHandler) restore(now time.Time) {
+	restored := 0
+
+	err := storage.ScanAPILogs(now.Add(-gh.retention), now, false, nil, func(key []byte, apiLog *protobuf.APILog) bool {
+		gh.record(apiLog, storage.RecordTime(key))
+		restored++
+		return true
+	})
+	if err == storage.ErrStoreDisabled {
+		return
+	} else if err != nil {
+		log.Printf("[Graph] Failed to restore the service graph: %v", err)
+	}
+
+	gh.lock.Lock()
+	defer gh.lock.Unlock()
+
+	gh.updatedEdges = make(map[string]struct{})
+
+	if restored > 0 {
+		log.Printf("[Graph] Restored the service graph from %d stored API logs (%d nodes, %d edges)", restored, len(gh.nodes), len(gh.edges))
+	}
+}
+
+// updateGraph Function that periodically expires old traffic and sends changes to watchers
+func (gh *GraphHandler) updateGraph(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	ticker := time.NewTicker(gh.updatePeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			gh.update(time.Now())
+
+		case <-gh.stopChan:
+			return
+		}
+	}
+}
+
+// update Function that expires old traffic and sends the changes since the last update to watchers
+func (gh *GraphHandler) update(now time.Time) {
+	gh.lock.Lock()
+	defer gh.lock.Unlock()
+
+	gh.expire(now)
+
+	for watcher := range gh.watchers {
+		for _, change := range gh.sync(watcher, gh.updatedEdges, now) {
+			if !watcher.send(change) {
+				// A watcher that cannot keep up would miss changes, so it has to watch again
+				delete(gh.watchers, watcher)
+				watcher.close(errWatcherBehind)
+				break
+			}
+		}
+	}
+
+	gh.updatedEdges = make(map[string]struct{})
+}
+
+// == //
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// watcherBuffer is the number of changes that a watcher may fall behind
+const watcherBuffer = 4096
+
+// Reasons for closing a watcher
+var (
+	errWatcherBehind = errors.New("the watcher fell behind the changes of the service graph")
+	errGraphStopped  = errors.New("the service graph is stopped")
+)
+
+// Watcher Structure that follows the changes of the service graph within a query
+// (its view is what GetServiceGraph returns for the same query)
+type Watcher struct {
+	query  *protobuf.ServiceGraphQuery
+	window time.Duration
+
+	changes chan *protobuf.ServiceGraphChange
+	err     error
+
+	nodes map[string]struct{} // nodes sent to the watcher
+	edges map[string]struct{} // edges sent to the watcher
+}
+
+// Watch Function that starts following the service graph, and returns the changes that
+// bring a watcher to the current graph
+func Watch(query *protobuf.ServiceGraphQuery) (*Watcher, []*protobuf.ServiceGraphChange) {
+	watcher := &Watcher{
+		query:   query,
+		window:  time.Duration(query.Window) * time.Second,
+		changes: make(chan *protobuf.ServiceGraphChange, watcherBuffer),
+		nodes:   make(map[string]struct{}),
+		edges:   make(map[string]struct{}),
+	}
+
+	GraH.lock.Lock()
+	defer GraH.lock.Unlock()
+
+	all := make(map[string]struct{}, len(GraH.edges))
+	for id := range GraH.edges {
+		all[id] = struct{}{}
+	}
+
+	initial := GraH.sync(watcher, all, time.Now())
+	GraH.watchers[watcher] = struct{}{}
+
+	return watcher, initial
+}
+
+// Unwatch Function that stops following the service graph
+func Unwatch(watcher *Watcher) {
+	GraH.lock.Lock()
+	defer GraH.lock.Unlock()
+
+	delete(GraH.watchers, watcher)
+}
+
+// Changes Function that returns the channel of changes (closed when the watcher is closed)
+func (watcher *Watcher) Changes() <-chan *protobuf.ServiceGraphChange {
+	return watcher.changes
+}
+
+// Err Function that returns why a watcher was closed
+func (watcher *Watcher) Err() error {
+	return watcher.err
+}
+
+// send Function that queues a change (false if the watcher is too far behind)
+func (watcher *Watcher) send(change *protobuf.ServiceGraphChange) bool {
+	select {
+	case watcher.changes <- change:
+		return true
+	default:
+		return false
+	}
+}
+
+// close Function
+func (watcher *Watcher) close(err error) {
+	watcher.err = err
+	close(watcher.changes)
+}
+
+// == //
+
+// sync Function that returns the changes that bring a watcher from what it was sent to the current graph
+// (edges without new calls are not sent again until they leave its window)
+func (gh *GraphHandler) sync(watcher *Watcher, updated map[string]struct{}, now time.Time) []*protobuf.ServiceGraphChange {
+	changes := make([]*protobuf.ServiceGraphChange, 0)
+	timeStamp := now.UTC().Format(time.RFC3339)
+
+	// Edges that were expired or left the window
+	for id := range watcher.edges {
+		edge, ok := gh.edges[id]
+		if ok && edge.stats(watcher.window, now) != nil {
+			continue
+		}
+
+		removed := &protobuf.GraphEdge{Id: id}
+		if ok {
+			removed.Source = edge.source
+			removed.Destination = edge.destination
+		}
+
+		changes = append(changes, &protobuf.ServiceGraphChange{Type: "EdgeRemoved", TimeStamp: timeStamp, Edge: removed})
+		delete(watcher.edges, id)
+	}
+
+	// Edges with new calls
+	for id := range updated {
+		edge, ok := gh.edges[id]
+		if !ok || !gh.inScope(watcher.query, edge) {
+			continue
+		}
+
+		stats := edge.stats(watcher.window, now)
+		if stats == nil {
+			continue
+		}
+
+		for _, nodeID := range []string{edge.source, edge.destination} {
+			if _, sent := watcher.nodes[nodeID]; !sent {
+				changes = append(changes, &protobuf.ServiceGraphChange{Type: "NodeAdded", TimeStamp: timeStamp, Node: gh.nodeOf(nodeID)})
+				watcher.nodes[nodeID] = struct{}{}
+			}
+		}
+
+		changeType := "EdgeUpdated"
+		if _, sent := watcher.edges[id]; !sent {
+			changeType = "EdgeAdded"
+			watcher.edges[id] = struct{}{}
+		}
+
+		changes = append(changes, &protobuf.ServiceGraphChange{Type: changeType, TimeStamp: timeStamp, Edge: stats})
+	}
+
+	// Nodes left without edges
+	referenced := make(map[string]struct{}, len(watcher.nodes))
+	for id := range watcher.edges {
+		if edge, ok := gh.edges[id]; ok {
+			referenced[edge.source] = struct{}{}
+			referenced[edge.destination] = struct{}{}
+		}
+	}
+
+	for id := range watcher.nodes {
+		if _, ok := referenced[id]; ok {
+			continue
+		}
+
+		removed := gh.nodeOf(id)
+		changes = append(changes, &protobuf.ServiceGraphChange{Type: "NodeRemoved", TimeStamp: timeStamp, Node: removed})
+		delete(watcher.nodes, id)
+	}
+
+	return changes
+}
+
+// == //
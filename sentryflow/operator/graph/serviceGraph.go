@@ -0,0 +1,456 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+)
+
+// == //
+
+// graphBucket is the time resolution of the traffic kept for an edge
+const graphBucket = time.Minute
+
+// maxEdgeEndpoints bounds the endpoints counted for an edge (the rest are counted under "*")
+const maxEdgeEndpoints = 100
+
+// latencyBounds are the upper bounds (in milliseconds) of the latency histogram of an edge
+var latencyBounds = []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000, 10000, 30000}
+
+// podLabels are the labels that differ between the pods of a workload
+var podLabels = []string{"pod-template-hash", "controller-revision-hash", "pod-template-generation",
+	"statefulset.kubernetes.io/pod-name", "apps.kubernetes.io/pod-index"}
+
+// graphNode Structure
+type graphNode struct {
+	node *protobuf.GraphNode
+
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+// graphEdge Structure
+type graphEdge struct {
+	id          string
+	source      string
+	destination string
+
+	firstSeen time.Time
+	lastSeen  time.Time
+
+	buckets []*edgeBucket // oldest first
+}
+
+// edgeBucket Structure that counts the calls of an edge in a time bucket
+type edgeBucket struct {
+	start time.Time
+
+	requests uint64
+	errors   uint64
+	latency  []uint64 // histogram over latencyBounds, and one more for the slower calls
+
+	methods   map[string]uint64
+	endpoints map[string]uint64
+}
+
+// == //
+
+// record Function that adds the call of an API log to the graph
+func (gh *GraphHandler) record(apiLog *protobuf.APILog, at time.Time) {
+	src := sourceNode(apiLog)
+	dst := destinationNode(apiLog)
+
+	gh.lock.Lock()
+	defer gh.lock.Unlock()
+
+	gh.touchNode(src, at)
+	gh.touchNode(dst, at)
+
+	id := src.Id + ">" + dst.Id
+
+	edge, ok := gh.edges[id]
+	if !ok {
+		edge = &graphEdge{id: id, source: src.Id, destination: dst.Id, firstSeen: at}
+		gh.edges[id] = edge
+	}
+
+	edge.record(apiLog, at)
+	gh.updatedEdges[id] = struct{}{}
+}
+
+// touchNode Function that adds a node or refreshes the one with the same ID
+func (gh *GraphHandler) touchNode(node *protobuf.GraphNode, at time.Time) {
+	known, ok := gh.nodes[node.Id]
+	if !ok {
+		gh.nodes[node.Id] = &graphNode{node: node, firstSeen: at, lastSeen: at}
+		return
+	}
+
+	if at.After(known.lastSeen) {
+		known.lastSeen = at
+		known.node = node
+	}
+}
+
+// record Function that counts a call in the bucket of its time
+func (edge *graphEdge) record(apiLog *protobuf.APILog, at time.Time) {
+	if at.Before(edge.firstSeen) {
+		edge.firstSeen = at
+	}
+	if at.After(edge.lastSeen) {
+		edge.lastSeen = at
+	}
+
+	bucket := edge.bucketAt(at.Truncate(graphBucket))
+
+	bucket.requests++
+	if failedCall(apiLog) {
+		bucket.errors++
+	}
+
+	latency := apiLog.ClientLatency
+	if latency == 0 {
+		latency = apiLog.ServerLatency
+	}
+	if latency > 0 {
+		bucket.latency[latencyIndex(float64(latency)/float64(time.Millisecond))]++
+	}
+
+	if apiLog.Method != "" {
+		countKey(bucket.methods, strings.ToUpper(apiLog.Method), 1)
+	}
+	if path := endpointPath(apiLog.Path); path != "" {
+		countKey(bucket.endpoints, strings.TrimSpace(strings.ToUpper(apiLog.Method)+" "+path), 1)
+	}
+}
+
+// bucketAt Function that returns the bucket that starts at a given time, adding it if needed
+// (calls mostly arrive in time order, so buckets are searched from the newest)
+func (edge *graphEdge) bucketAt(start time.Time) *edgeBucket {
+	i := len(edge.buckets)
+	for i > 0 && edge.buckets[i-1].start.After(start) {
+		i--
+	}
+	if i > 0 && edge.buckets[i-1].start.Equal(start) {
+		return edge.buckets[i-1]
+	}
+
+	bucket := &edgeBucket{
+		start:     start,
+		latency:   make([]uint64, len(latencyBounds)+1),
+		methods:   make(map[string]uint64),
+		endpoints: make(map[string]uint64),
+	}
+
+	edge.buckets = append(edge.buckets, nil)
+	copy(edge.buckets[i+1:], edge.buckets[i:])
+	edge.buckets[i] = bucket
+
+	return bucket
+}
+
+// stats Function that sums up the calls of an edge within a window
+// (nil if the edge had no calls in it)
+func (edge *graphEdge) stats(window time.Duration, now time.Time) *protobuf.GraphEdge {
+	stats := &protobuf.GraphEdge{
+		Id:          edge.id,
+		Source:      edge.source,
+		Destination: edge.destination,
+		Methods:     make(map[string]uint64),
+		Endpoints:   make(map[string]uint64),
+		FirstSeen:   edge.firstSeen.Unix(),
+		LastSeen:    edge.lastSeen.Unix(),
+	}
+
+	latency := make([]uint64, len(latencyBounds)+1)
+
+	for _, bucket := range edge.buckets {
+		if window > 0 && !bucket.start.Add(graphBucket).After(now.Add(-window)) {
+			continue
+		}
+
+		stats.Requests += bucket.requests
+		stats.Errors += bucket.errors
+
+		for i, count := range bucket.latency {
+			latency[i] += count
+		}
+		for method, count := range bucket.methods {
+			countKey(stats.Methods, method, count)
+		}
+		for endpoint, count := range bucket.endpoints {
+			countKey(stats.Endpoints, endpoint, count)
+		}
+	}
+
+	if stats.Requests == 0 {
+		return nil
+	}
+
+	stats.ErrorRate = float64(stats.Errors) / float64(stats.Requests)
+
+	stats.LatencyP50 = percentile(latency, 0.50)
+	stats.LatencyP90 = percentile(latency, 0.90)
+	stats.LatencyP99 = percentile(latency, 0.99)
+
+	return stats
+}
+
+// == //
+
+// snapshot Function that returns the edges of a query with the nodes at their ends
+func (gh *GraphHandler) snapshot(query *protobuf.ServiceGraphQuery, now time.Time) *protobuf.ServiceGraph {
+	graph := &protobuf.ServiceGraph{
+		TimeStamp: now.UTC().Format(time.RFC3339),
+		Nodes:     make([]*protobuf.GraphNode, 0),
+		Edges:     make([]*protobuf.GraphEdge, 0),
+	}
+
+	window := time.Duration(query.Window) * time.Second
+	nodes := make(map[string]struct{})
+
+	for _, edge := range gh.edges {
+		if !gh.inScope(query, edge) {
+			continue
+		}
+
+		stats := edge.stats(window, now)
+		if stats == nil {
+			continue
+		}
+
+		graph.Edges = append(graph.Edges, stats)
+		nodes[edge.source] = struct{}{}
+		nodes[edge.destination] = struct{}{}
+	}
+
+	for id := range nodes {
+		graph.Nodes = append(graph.Nodes, gh.nodeOf(id))
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool { return graph.Nodes[i].Id < graph.Nodes[j].Id })
+	sort.Slice(graph.Edges, func(i, j int) bool { return graph.Edges[i].Id < graph.Edges[j].Id })
+
+	return graph
+}
+
+// inScope Function that checks if either end of an edge is in the cluster and namespace of a query
+func (gh *GraphHandler) inScope(query *protobuf.ServiceGraphQuery, edge *graphEdge) bool {
+	if query.Cluster == "" && query.Namespace == "" {
+		return true
+	}
+
+	for _, id := range []string{edge.source, edge.destination} {
+		node, ok := gh.nodes[id]
+		if !ok {
+			continue
+		}
+		if (query.Cluster == "" || query.Cluster == node.node.Cluster) &&
+			(query.Namespace == "" || query.Namespace == node.node.Namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nodeOf Function that returns a node as it is sent to clients
+func (gh *GraphHandler) nodeOf(id string) *protobuf.GraphNode {
+	known, ok := gh.nodes[id]
+	if !ok {
+		return &protobuf.GraphNode{Id: id}
+	}
+
+	return &protobuf.GraphNode{
+		Id:        known.node.Id,
+		Cluster:   known.node.Cluster,
+		Namespace: known.node.Namespace,
+		Name:      known.node.Name,
+		Type:      known.node.Type,
+		Labels:    known.node.Labels,
+		FirstSeen: known.firstSeen.Unix(),
+		LastSeen:  known.lastSeen.Unix(),
+	}
+}
+
+// expire Function that drops the buckets older than the retention, and then the edges and
+// nodes left without traffic
+func (gh *GraphHandler) expire(now time.Time) {
+	oldest := now.Add(-gh.retention)
+
+	for id, edge := range gh.edges {
+		kept := 0
+		for kept < len(edge.buckets) && !edge.buckets[kept].start.Add(graphBucket).After(oldest) {
+			kept++
+		}
+		edge.buckets = edge.buckets[kept:]
+
+		if len(edge.buckets) == 0 {
+			delete(gh.edges, id)
+			delete(gh.updatedEdges, id)
+		}
+	}
+
+	referenced := make(map[string]struct{})
+	for _, edge := range gh.edges {
+		referenced[edge.source] = struct{}{}
+		referenced[edge.destination] = struct{}{}
+	}
+
+	for id := range gh.nodes {
+		if _, ok := referenced[id]; !ok {
+			delete(gh.nodes, id)
+		}
+	}
+}
+
+// == //
+
+// sourceNode Function that returns the node that made the call of an API log
+func sourceNode(apiLog *protobuf.APILog) *protobuf.GraphNode {
+	switch apiLog.SrcType {
+	case "", "Unknown":
+		return newGraphNode(apiLog.SrcCluster, apiLog.SrcNamespace, "external", "External", nil)
+	case "Service":
+		return newGraphNode(apiLog.SrcCluster, apiLog.SrcNamespace, apiLog.SrcName, "Service", nil)
+	default:
+		return newGraphNode(apiLog.SrcCluster, apiLog.SrcNamespace, workloadName(apiLog.SrcName, apiLog.SrcLabel),
+			"Workload", workloadLabels(apiLog.SrcLabel))
+	}
+}
+
+// destinationNode Function that returns the node that served the call of an API log
+// (calls to a service go to the service, not to the pod that happened to serve them)
+func destinationNode(apiLog *protobuf.APILog) *protobuf.GraphNode {
+	if apiLog.DstService != "" {
+		return newGraphNode(apiLog.DstCluster, apiLog.DstNamespace, apiLog.DstService, "Service", nil)
+	}
+
+	switch apiLog.DstType {
+	case "", "Unknown":
+		name := apiLog.Host
+		if name == "" {
+			name = apiLog.DstIP
+		}
+		return newGraphNode(apiLog.DstCluster, apiLog.DstNamespace, name, "External", nil)
+	case "Service":
+		return newGraphNode(apiLog.DstCluster, apiLog.DstNamespace, apiLog.DstName, "Service", nil)
+	default:
+		return newGraphNode(apiLog.DstCluster, apiLog.DstNamespace, workloadName(apiLog.DstName, apiLog.DstLabel),
+			"Workload", workloadLabels(apiLog.DstLabel))
+	}
+}
+
+// newGraphNode Function
+func newGraphNode(cluster, namespace, name, nodeType string, labels map[string]string) *protobuf.GraphNode {
+	return &protobuf.GraphNode{
+		Id:        fmt.Sprintf("%s/%s/%s/%s", cluster, namespace, nodeType, name),
+		Cluster:   cluster,
+		Namespace: namespace,
+		Name:      name,
+		Type:      nodeType,
+		Labels:    labels,
+	}
+}
+
+// workloadName Function that returns the name of the workload that runs a pod
+// (the pod name without the suffixes of its controller, e.g., "reviews-v1-7d9f8c6b5-x2kqz" -> "reviews-v1")
+func workloadName(podName string, labels map[string]string) string {
+	if hash, ok := labels["pod-template-hash"]; ok {
+		if i := strings.LastIndex(podName, "-"+hash+"-"); i > 0 {
+			return podName[:i]
+		}
+	}
+
+	if _, ok := labels["controller-revision-hash"]; ok {
+		if i := strings.LastIndex(podName, "-"); i > 0 {
+			return podName[:i]
+		}
+	}
+
+	return podName
+}
+
+// workloadLabels Function that returns the labels that the pods of a workload share
+func workloadLabels(labels map[string]string) map[string]string {
+	shared := make(map[string]string, len(labels))
+	for key, value := range labels {
+		shared[key] = value
+	}
+	for _, key := range podLabels {
+		delete(shared, key)
+	}
+	return shared
+}
+
+// failedCall Function that checks if the call of an API log failed
+// (no response, a server error, or a DNS error code)
+func failedCall(apiLog *protobuf.APILog) bool {
+	if apiLog.Protocol == "DNS" {
+		return apiLog.ResponseCode != 0
+	}
+	return apiLog.ResponseCode == 0 || apiLog.ResponseCode >= 500
+}
+
+// endpointPath Function that returns the path of a call without its query string
+func endpointPath(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
+
+// countKey Function that adds to the count of a key, counting new keys under "*" once there are too many
+func countKey(counts map[string]uint64, key string, n uint64) {
+	if _, ok := counts[key]; !ok && len(counts) >= maxEdgeEndpoints {
+		key = "*"
+	}
+	counts[key] += n
+}
+
+// latencyIndex Function that returns the histogram bucket of a latency
+func latencyIndex(latency float64) int {
+	return sort.SearchFloat64s(latencyBounds, latency)
+}
+
+// percentile Function that estimates a percentile (in milliseconds) from a latency histogram
+// (interpolated within the bucket that holds it)
+func percentile(histogram []uint64, q float64) float64 {
+	total := uint64(0)
+	for _, count := range histogram {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+
+	target := q * float64(total)
+	seen := uint64(0)
+
+	for i, count := range histogram {
+		if count == 0 || float64(seen+count) < target {
+			seen += count
+			continue
+		}
+
+		if i == len(latencyBounds) {
+			return latencyBounds[len(latencyBounds)-1]
+		}
+
+		lower := 0.0
+		if i > 0 {
+			lower = latencyBounds[i-1]
+		}
+
+		return lower + (latencyBounds[i]-lower)*(target-float64(seen))/float64(count)
+	}
+
+	return latencyBounds[len(latencyBounds)-1]
+}
+
+// == //
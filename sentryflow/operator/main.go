@@ -3,6 +3,9 @@
 package main
 
 import (
+	"log"
+
+	"Operator/config"
 	"Operator/core"
 )
 
@@ -11,5 +14,9 @@ import (
 // ========== //
 
 func main() {
+	if err := config.ParseFlags(); err != nil {
+		log.Fatalf("Unable to load the configuration: %v", err)
+	}
+
 	core.Operator()
 }
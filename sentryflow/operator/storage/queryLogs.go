@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+// ErrStoreDisabled is returned by queries when no store is configured
+var ErrStoreDisabled = errors.New("the store is disabled")
+
+// errStopScan stops a scan once the caller has seen enough
+var errStopScan = errors.New("stop scan")
+
+// RecordKeySize is the size of the keys that locate a stored record
+const RecordKeySize = 16
+
+// ScanAPILogs Function that visits the API logs received between since and until in time order
+// (starting next to the given key if any) until visit returns false
+func ScanAPILogs(since, until time.Time, descending bool, after []byte, visit func(key []byte, apiLog *protobuf.APILog) bool) error {
+	if StoH.db == nil {
+		return ErrStoreDisabled
+	}
+
+	lower := recordKey(since, 0)
+	upper := recordKey(until, math.MaxUint64)
+
+	// Scans resume from the last key of the previous page
+	start := lower
+	if descending {
+		start = upper
+	}
+	if after != nil {
+		if !descending && bytes.Compare(after, lower) > 0 {
+			start = after
+		} else if descending && bytes.Compare(after, upper) < 0 {
+			start = after
+		}
+	}
+
+	err := StoH.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(apiLogsBucket)
+
+		partitions := make([][]byte, 0)
+		if err := bucket.ForEachBucket(func(name []byte) error {
+			partitions = append(partitions, append([]byte(nil), name...))
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if descending {
+			for i, j := 0, len(partitions)-1; i < j; i, j = i+1, j-1 {
+				partitions[i], partitions[j] = partitions[j], partitions[i]
+			}
+		}
+
+		for _, name := range partitions {
+			// Partitions that start after the range hold nothing of it
+			if partStart, err := time.Parse("20060102T1504", string(name)); err == nil && partStart.After(until) {
+				if descending {
+					continue
+				}
+				break
+			}
+
+			cursor := bucket.Bucket(name).Cursor()
+
+			key, data := cursor.Seek(start)
+			if descending {
+				if key == nil {
+					key, data = cursor.Last()
+				} else if bytes.Compare(key, start) > 0 {
+					key, data = cursor.Prev()
+				}
+			}
+
+			for ; key != nil; key, data = step(cursor, descending) {
+				if (!descending && bytes.Compare(key, upper) > 0) || (descending && bytes.Compare(key, lower) < 0) {
+					break
+				}
+				if after != nil && bytes.Equal(key, after) {
+					continue
+				}
+
+				apiLog := &protobuf.APILog{}
+				if err := proto.Unmarshal(data, apiLog); err != nil {
+					log.Printf("[Store] Skipped a corrupted API log: %v", err)
+					continue
+				}
+
+				if !visit(key, apiLog) {
+					return errStopScan
+				}
+			}
+		}
+
+		return nil
+	})
+	if err == errStopScan {
+		return nil
+	}
+
+	return err
+}
+
+// step Function that moves a cursor forward or backward
+func step(cursor *bolt.Cursor, descending bool) ([]byte, []byte) {
+	if descending {
+		return cursor.Prev()
+	}
+	return cursor.Next()
+}
+
+// == //
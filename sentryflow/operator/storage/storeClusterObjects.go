@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"log"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+// clusterObjectTypes maps the resource types kept in the store to their messages
+var clusterObjectTypes = map[string]func() proto.Message{
+	"Pod":              func() proto.Message { return &protobuf.Pod{} },
+	"Service":          func() proto.Message { return &protobuf.Service{} },
+	"Deploy":           func() proto.Message { return &protobuf.Deploy{} },
+	"StatefulSet":      func() proto.Message { return &protobuf.StatefulSet{} },
+	"DaemonSet":        func() proto.Message { return &protobuf.DaemonSet{} },
+	"Job":              func() proto.Message { return &protobuf.Job{} },
+	"CronJob":          func() proto.Message { return &protobuf.CronJob{} },
+	"Node":             func() proto.Message { return &protobuf.Node{} },
+	"Namespace":        func() proto.Message { return &protobuf.Namespace{} },
+	"Ingress":          func() proto.Message { return &protobuf.Ingress{} },
+	"ServiceEndpoints": func() proto.Message { return &protobuf.ServiceEndpoints{} },
+}
+
+// ClusterObjectTypes Function that returns the resource types kept in the store
+func ClusterObjectTypes() []string {
+	return []string{"Namespace", "Node", "Deploy", "StatefulSet", "DaemonSet", "Job", "CronJob",
+		"Pod", "Service", "ServiceEndpoints", "Ingress"}
+}
+
+// == //
+
+// PutClusterObject Function that persists the last known state of an object
+func PutClusterObject(resourceType string, key string, obj proto.Message) {
+	if StoH.db == nil {
+		return
+	}
+
+	data, err := proto.Marshal(obj)
+	if err != nil {
+		log.Printf("[Store] Failed to encode %s %s: %v", resourceType, key, err)
+		return
+	}
+
+	// Concurrent events of the gRPC handlers are written together
+	err = StoH.db.Batch(func(tx *bolt.Tx) error {
+		objects, err := tx.Bucket(clusterObjectsBucket).CreateBucketIfNotExists([]byte(resourceType))
+		if err != nil {
+			return err
+		}
+		return objects.Put([]byte(key), data)
+	})
+	if err != nil {
+		log.Printf("[Store] Failed to store %s %s: %v", resourceType, key, err)
+	}
+}
+
+// DeleteClusterObject Function that forgets an object
+func DeleteClusterObject(resourceType string, key string) {
+	if StoH.db == nil {
+		return
+	}
+
+	err := StoH.db.Batch(func(tx *bolt.Tx) error {
+		if objects := tx.Bucket(clusterObjectsBucket).Bucket([]byte(resourceType)); objects != nil {
+			return objects.Delete([]byte(key))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[Store] Failed to delete %s %s: %v", resourceType, key, err)
+	}
+}
+
+// LoadClusterObjects Function that returns the stored objects of a resource type
+func LoadClusterObjects(resourceType string) []proto.Message {
+	objects := make([]proto.Message, 0)
+
+	newObject, ok := clusterObjectTypes[resourceType]
+	if StoH.db == nil || !ok {
+		return objects
+	}
+
+	err := StoH.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(clusterObjectsBucket).Bucket([]byte(resourceType))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(key, data []byte) error {
+			obj := newObject()
+			if err := proto.Unmarshal(data, obj); err != nil {
+				log.Printf("[Store] Skipped a corrupted %s %s: %v", resourceType, string(key), err)
+				return nil
+			}
+			objects = append(objects, obj)
+			return nil
+		})
+	})
+	if err != nil {
+		log.Printf("[Store] Failed to load %s objects: %v", resourceType, err)
+	}
+
+	return objects
+}
+
+// == //
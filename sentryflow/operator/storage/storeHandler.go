@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"Operator/config"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// == //
+
+// Buckets of the store
+var (
+	clusterObjectsBucket = []byte("clusterObjects") // sub-buckets per resource type, key: "cluster/object key"
+	apiLogsBucket        = []byte("apiLogs")        // sub-buckets per time partition
+	envoyMetricsBucket   = []byte("envoyMetrics")   // sub-buckets per time partition
+)
+
+// StoH global reference for Store Handler
+var StoH *StoreHandler
+
+// init Function
+func init() {
+	StoH = NewStoreHandler()
+}
+
+// StoreHandler Structure
+type StoreHandler struct {
+	db *bolt.DB // nil when the store is disabled
+
+	partition time.Duration
+	retention time.Duration
+	maxSize   int64
+
+	storeAPILogs      chan *protobuf.APILog
+	storeEnvoyMetrics chan *protobuf.EnvoyMetrics
+
+	stopChan chan struct{}
+	doneChan chan struct{} // storeLogs tells when its last writes are done
+}
+
+// NewStoreHandler Function
+func NewStoreHandler() *StoreHandler {
+	sh := &StoreHandler{
+		storeAPILogs:      make(chan *protobuf.APILog, 4096),
+		storeEnvoyMetrics: make(chan *protobuf.EnvoyMetrics, 1024),
+
+		stopChan: make(chan struct{}),
+		doneChan: make(chan struct{}),
+	}
+
+	return sh
+}
+
+// == //
+
+// StartStore Function
+func StartStore(wg *sync.WaitGroup) bool {
+	if config.GlobalConfig.StorePath == "" {
+		log.Print("[Store] No store path is given, nothing will be persisted")
+		return true
+	}
+
+	StoH.partition = time.Duration(config.GlobalConfig.StorePartition) * time.Minute
+	if StoH.partition <= 0 {
+		StoH.partition = time.Hour
+	}
+	StoH.retention = time.Duration(config.GlobalConfig.StoreRetention) * time.Hour
+	StoH.maxSize = int64(config.GlobalConfig.StoreMaxSize) * 1024 * 1024
+
+	if err := os.MkdirAll(filepath.Dir(config.GlobalConfig.StorePath), 0750); err != nil {
+		log.Printf("[Store] Failed to create the directory of %s: %v", config.GlobalConfig.StorePath, err)
+		return false
+	}
+
+	db, err := bolt.Open(config.GlobalConfig.StorePath, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		log.Printf("[Store] Failed to open %s: %v", config.GlobalConfig.StorePath, err)
+		return false
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{clusterObjectsBucket, apiLogsBucket, envoyMetricsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[Store] Failed to initialize %s: %v", config.GlobalConfig.StorePath, err)
+		_ = db.Close()
+		return false
+	}
+
+	StoH.db = db
+
+	log.Printf("[Store] Opened %s (retention=%v, maxSize=%dMB, partition=%v)",
+		config.GlobalConfig.StorePath, StoH.retention, config.GlobalConfig.StoreMaxSize, StoH.partition)
+
+	// Write logs and metrics
+	go StoH.storeLogs(wg)
+
+	// Drop outdated logs and metrics
+	go StoH.enforceRetention(wg)
+
+	return true
+}
+
+// StopStore Function
+func StopStore() bool {
+	if StoH.db == nil {
+		return true
+	}
+
+	// One for storeLogs
+	StoH.stopChan <- struct{}{}
+
+	// One for enforceRetention
+	StoH.stopChan <- struct{}{}
+
+	// storeLogs writes what it holds before it returns
+	<-StoH.doneChan
+
+	if err := StoH.db.Close(); err != nil {
+		log.Printf("[Store] Failed to close %s: %v", config.GlobalConfig.StorePath, err)
+		return false
+	}
+
+	log.Print("[Store] Closed the store")
+
+	return true
+}
+
+// == //
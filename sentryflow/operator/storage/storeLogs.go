@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"encoding/binary"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Jitria/SentryFlow/protobuf"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// == //
+
+// storeBatchSize is the number of logs and metrics written in one transaction at most
+const storeBatchSize = 512
+
+// storedRecord Structure that keeps an encoded log or metric until it is written
+type storedRecord struct {
+	bucket []byte
+	at     time.Time
+	data   []byte
+}
+
+// InsertAPILog Function
+func InsertAPILog(apiLog *protobuf.APILog) {
+	if StoH.db == nil {
+		return
+	}
+	StoH.storeAPILogs <- apiLog
+}
+
+// InsertEnvoyMetrics Function
+func InsertEnvoyMetrics(evyMetrics *protobuf.EnvoyMetrics) {
+	if StoH.db == nil {
+		return
+	}
+	StoH.storeEnvoyMetrics <- evyMetrics
+}
+
+// == //
+
+// partitionName Function that returns the time partition that a time falls into
+// (names sort in time order, e.g., "20240102T1500")
+func (sh *StoreHandler) partitionName(at time.Time) []byte {
+	return []byte(at.UTC().Truncate(sh.partition).Format("20060102T1504"))
+}
+
+// recordKey Function that returns the key of a record (its time in nanoseconds and a sequence number)
+// (keys sort in time order within a partition)
+func recordKey(at time.Time, seq uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key[:8], uint64(at.UnixNano()))
+	binary.BigEndian.PutUint64(key[8:], seq)
+	return key
+}
+
+// RecordTime Function that returns the time when the record of a key was received
+func RecordTime(key []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key[:8])))
+}
+
+// storeLogs Function that writes API logs and metrics in batches
+func (sh *StoreHandler) storeLogs(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	flushTicker := time.NewTicker(time.Second)
+	defer flushTicker.Stop()
+
+	pending := make([]*storedRecord, 0, storeBatchSize)
+
+	queue := func(bucket []byte, msg proto.Message) {
+		data, err := proto.Marshal(msg)
+		if err != nil {
+			log.Printf("[Store] Failed to encode a record: %v", err)
+			return
+		}
+
+		pending = append(pending, &storedRecord{bucket: bucket, at: time.Now(), data: data})
+		if len(pending) >= storeBatchSize {
+			sh.writeRecords(pending)
+			pending = pending[:0]
+		}
+	}
+
+	for {
+		select {
+		case apiLog := <-sh.storeAPILogs:
+			queue(apiLogsBucket, apiLog)
+
+		case evyMetrics := <-sh.storeEnvoyMetrics:
+			queue(envoyMetricsBucket, evyMetrics)
+
+		case <-flushTicker.C:
+			sh.writeRecords(pending)
+			pending = pending[:0]
+
+		case <-sh.stopChan:
+			// What is still queued is written too
+			for drained := false; !drained; {
+				select {
+				case apiLog := <-sh.storeAPILogs:
+					queue(apiLogsBucket, apiLog)
+				case evyMetrics := <-sh.storeEnvoyMetrics:
+					queue(envoyMetricsBucket, evyMetrics)
+				default:
+					drained = true
+				}
+			}
+
+			sh.writeRecords(pending)
+			sh.doneChan <- struct{}{}
+			return
+		}
+	}
+}
+
+// writeRecords Function that writes records into their time partitions in one transaction
+func (sh *StoreHandler) writeRecords(records []*storedRecord) {
+	if len(records) == 0 {
+		return
+	}
+
+	err := sh.db.Update(func(tx *bolt.Tx) error {
+		for _, record := range records {
+			partition, err := tx.Bucket(record.bucket).CreateBucketIfNotExists(sh.partitionName(record.at))
+			if err != nil {
+				return err
+			}
+
+			seq, err := partition.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			if err := partition.Put(recordKey(record.at, seq), record.data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[Store] Failed to store %d records: %v", len(records), err)
+	}
+}
+
+// == //
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"bytes"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// == //
+
+// storedPartition Structure that describes a time partition of logs or metrics
+type storedPartition struct {
+	bucket []byte
+	name   []byte
+	size   int64
+	newest bool // the partition being written now
+}
+
+// enforceRetention Function that periodically drops the partitions that are too old or too many
+func (sh *StoreHandler) enforceRetention(wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	sh.dropPartitions(time.Now())
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			sh.dropPartitions(time.Now())
+
+		case <-sh.stopChan:
+			return
+		}
+	}
+}
+
+// dropPartitions Function that drops partitions older than the retention, and then the oldest
+// partitions until the logs and metrics fit in the maximum size
+// (the partition being written is always kept)
+func (sh *StoreHandler) dropPartitions(now time.Time) {
+	dropped := 0
+
+	err := sh.db.Update(func(tx *bolt.Tx) error {
+		partitions := make([]*storedPartition, 0)
+		total := int64(0)
+
+		for _, bucketName := range [][]byte{apiLogsBucket, envoyMetricsBucket} {
+			bucket := tx.Bucket(bucketName)
+
+			var newest *storedPartition
+			err := bucket.ForEachBucket(func(name []byte) error {
+				stats := bucket.Bucket(name).Stats()
+
+				partition := &storedPartition{
+					bucket: bucketName,
+					name:   append([]byte(nil), name...),
+					size:   int64(stats.BranchInuse + stats.LeafInuse + stats.InlineBucketInuse),
+				}
+				partitions = append(partitions, partition)
+				total += partition.size
+
+				newest = partition
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if newest != nil {
+				newest.newest = true
+			}
+		}
+
+		// Oldest first, across logs and metrics
+		sort.SliceStable(partitions, func(i, j int) bool {
+			return bytes.Compare(partitions[i].name, partitions[j].name) < 0
+		})
+
+		for _, partition := range partitions {
+			if partition.newest {
+				continue
+			}
+
+			tooOld := false
+			if start, err := time.Parse("20060102T1504", string(partition.name)); err == nil && sh.retention > 0 {
+				tooOld = now.Sub(start.Add(sh.partition)) > sh.retention
+			}
+			tooBig := sh.maxSize > 0 && total > sh.maxSize
+
+			if !tooOld && !tooBig {
+				continue
+			}
+
+			if err := tx.Bucket(partition.bucket).DeleteBucket(partition.name); err != nil {
+				return err
+			}
+
+			total -= partition.size
+			dropped++
+		}
+
+		if sh.maxSize > 0 && total > sh.maxSize {
+			log.Printf("[Store] Logs and metrics of the current partitions (%d bytes) exceed the maximum size (%d bytes)", total, sh.maxSize)
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Printf("[Store] Failed to enforce the retention: %v", err)
+		return
+	}
+
+	if dropped > 0 {
+		log.Printf("[Store] Dropped %d outdated partitions of logs and metrics", dropped)
+	}
+}
+
+// == //